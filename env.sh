@@ -7,3 +7,4 @@ DB_DATABASE="isuumo"
 PG_PASSWORD="isucon"
 REDIS_HOSTNAME="127.0.0.1"
 OTEL_SDK_DISABLED="true"
+DOCUMENT_REQUEST_DEDUPE_WINDOW="1h"
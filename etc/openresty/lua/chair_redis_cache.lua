@@ -1,6 +1,12 @@
 require "resty.core"
 local redis = require "resty.redis"
 
+-- 売り切れで隠すのは詳細の取得だけ。PUT/PATCH/DELETEはアプリに任せる
+local method = ngx.req.get_method()
+if method ~= "GET" and method ~= "HEAD" then
+    return
+end
+
 -- この例ではリクエストURIから数字を抽出します
 local uri = ngx.var.uri
 
@@ -0,0 +1,24 @@
+package main
+
+import (
+	"crypto/subtle"
+	"net/http"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+)
+
+// adminToken ADMIN_TOKENで決める管理用のトークン。空ならrequireAdminTokenを付けたAPIはすべて拒否する
+var adminToken string
+
+// requireAdminToken /api のうち掲載の書き換えや個人情報を返すAPIを、Authorization: Bearer <ADMIN_TOKEN> のリクエストだけに絞る
+func requireAdminToken(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		token, ok := strings.CutPrefix(c.Request().Header.Get(echo.HeaderAuthorization), "Bearer ")
+		if !ok || adminToken == "" || subtle.ConstantTimeCompare([]byte(token), []byte(adminToken)) != 1 {
+			c.Response().Header().Set(echo.HeaderWWWAuthenticate, "Bearer")
+			return newAPIError(http.StatusUnauthorized, ErrCodeUnauthorized, "valid admin token is required")
+		}
+		return next(c)
+	}
+}
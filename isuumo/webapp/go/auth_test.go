@@ -0,0 +1,53 @@
+package main
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+)
+
+func TestRequireAdminToken(t *testing.T) {
+	tests := []struct {
+		name          string
+		adminToken    string
+		authorization string
+		wantOK        bool
+	}{
+		{name: "valid", adminToken: "secret", authorization: "Bearer secret", wantOK: true},
+		{name: "wrong token", adminToken: "secret", authorization: "Bearer other"},
+		{name: "no header", adminToken: "secret"},
+		{name: "not bearer", adminToken: "secret", authorization: "Basic secret"},
+		{name: "token not configured", authorization: "Bearer "},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			adminToken = tt.adminToken
+			t.Cleanup(func() { adminToken = "" })
+
+			req := httptest.NewRequest(http.MethodPut, "/api/chair/1", nil)
+			if tt.authorization != "" {
+				req.Header.Set(echo.HeaderAuthorization, tt.authorization)
+			}
+			c := echo.New().NewContext(req, httptest.NewRecorder())
+			called := false
+			err := requireAdminToken(func(echo.Context) error {
+				called = true
+				return nil
+			})(c)
+
+			if tt.wantOK {
+				if err != nil || !called {
+					t.Errorf("requireAdminToken() error = %v, called = %v", err, called)
+				}
+				return
+			}
+			var ae *APIError
+			if called || !errors.As(err, &ae) || ae.Status != http.StatusUnauthorized {
+				t.Errorf("requireAdminToken() error = %v, called = %v, want 401", err, called)
+			}
+		})
+	}
+}
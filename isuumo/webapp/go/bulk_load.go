@@ -0,0 +1,448 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/stdlib"
+	"github.com/jmoiron/sqlx"
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	// DefaultInsertBatchSize 入稿のCSVを何行ずつDBに書くかの既定値。INSERT_BATCH_SIZEで変えられる
+	DefaultInsertBatchSize = 1000
+	// maxBindParams Postgresの1つの文に渡せるパラメータの上限。INSERTのときはバッチをこれに収める
+	maxBindParams = 65535
+)
+
+var insertBatchSize = DefaultInsertBatchSize
+
+// insertBatchSizeFromEnv INSERT_BATCH_SIZEを読む。起動時に呼び、不正な値なら起動しない
+func insertBatchSizeFromEnv() (int, error) {
+	s := getEnv("INSERT_BATCH_SIZE", strconv.Itoa(DefaultInsertBatchSize))
+	n, err := strconv.Atoi(s)
+	if err != nil || n <= 0 {
+		return 0, fmt.Errorf("INSERT_BATCH_SIZE must be a positive integer : %q", s)
+	}
+	return n, nil
+}
+
+// uploadMode 入稿で既存のidや読めない行をどう扱うか
+type uploadMode string
+
+const (
+	// uploadModeInsert 既存のidがあれば入稿全体を失敗にする。読めない行があっても同じ
+	uploadModeInsert uploadMode = "insert"
+	// uploadModeUpsert 既存のidの行は上書きする
+	uploadModeUpsert uploadMode = "upsert"
+	// uploadModeSkipInvalid 読めない行だけ飛ばして残りを入れる
+	uploadModeSkipInvalid uploadMode = "skip-invalid"
+)
+
+// uploadModeParam modeクエリパラメータを読む。省略したらinsert
+func uploadModeParam(c echo.Context) (uploadMode, error) {
+	switch m := uploadMode(c.QueryParam("mode")); m {
+	case "":
+		return uploadModeInsert, nil
+	case uploadModeInsert, uploadModeUpsert, uploadModeSkipInvalid:
+		return m, nil
+	default:
+		c.Logger().Infof("Invalid mode parameter : %v", m)
+		return "", newAPIError(http.StatusBadRequest, ErrCodeBadRequest, fmt.Sprintf("mode must be one of insert, upsert or skip-invalid : %q", m))
+	}
+}
+
+// uploadOptions 入稿の形式とクエリパラメータ。非同期の入稿ではジョブに残して、あとで同じように読む
+type uploadOptions struct {
+	Format uploadFormat
+	Mode   uploadMode
+	// Header CSVの1行目の列名で読む。古い入稿のためにfalseなら列の順番で読む
+	Header bool
+	// Async ジョブにしてすぐに返す
+	Async bool
+}
+
+func uploadOptionsParam(c echo.Context) (uploadOptions, error) {
+	var opts uploadOptions
+	var err error
+	if opts.Format, err = uploadFormatParam(c); err != nil {
+		return opts, err
+	}
+	if opts.Mode, err = uploadModeParam(c); err != nil {
+		return opts, err
+	}
+	if opts.Header, err = boolQueryParam(c, "header", false); err != nil {
+		return opts, err
+	}
+	if opts.Header && opts.Format != uploadFormatCSV {
+		c.Logger().Infof("header parameter with %s upload", opts.Format)
+		return opts, newAPIError(http.StatusBadRequest, ErrCodeBadRequest, fmt.Sprintf("header can only be used with csv uploads : %s", opts.Format))
+	}
+	if opts.Async, err = boolQueryParam(c, "async", false); err != nil {
+		return opts, err
+	}
+	return opts, nil
+}
+
+// UploadResponse 入稿へのレスポンスの形式。行番号はCSVとNDJSONでは1始まりの行、JSONの配列では1始まりの要素の番号
+type UploadResponse struct {
+	Inserted      int64          `json:"inserted"`
+	Updated       int64          `json:"updated"`
+	Rejected      int64          `json:"rejected"`
+	InsertedLines []int          `json:"insertedLines"`
+	UpdatedLines  []int          `json:"updatedLines"`
+	RejectedLines []RejectedLine `json:"rejectedLines"`
+
+	// updatedIDs 上書きした行。コミットの後にnginxのキャッシュを消すのに使う
+	updatedIDs []int64
+}
+
+type RejectedLine struct {
+	Line  int    `json:"line"`
+	Error string `json:"error"`
+}
+
+// uploadRow 入稿の1行。ValuesはbulkLoaderのcolumnsの順に並べる
+type uploadRow struct {
+	Line   int
+	ID     int64
+	Values []interface{}
+}
+
+// uploadRowError 1行を読めなかった理由。skip-invalidならその行だけ飛ばす
+type uploadRowError struct {
+	Line int
+	Err  error
+}
+
+func (e *uploadRowError) Error() string {
+	return fmt.Sprintf("line %d: %v", e.Line, e.Err)
+}
+
+func (e *uploadRowError) Unwrap() error {
+	return e.Err
+}
+
+func newUploadCSVReader(f io.Reader) *csv.Reader {
+	r := csv.NewReader(f)
+	r.ReuseRecord = true
+	return r
+}
+
+// csvRowSource CSVを1行ずつ読んでmapRecordで値にする。読めなかった行はuploadRowErrorを返す
+func csvRowSource(r *csv.Reader, mapRecord func(rm *RecordMapper) uploadRow) func() (uploadRow, error) {
+	return func() (uploadRow, error) {
+		record, err := r.Read()
+		if err != nil {
+			var pe *csv.ParseError
+			if errors.As(err, &pe) {
+				return uploadRow{}, &uploadRowError{Line: pe.StartLine, Err: pe.Err}
+			}
+			return uploadRow{}, err
+		}
+		line, _ := r.FieldPos(0)
+		rm := RecordMapper{Record: record}
+		row := mapRecord(&rm)
+		if err := rm.Err(); err != nil {
+			return uploadRow{}, &uploadRowError{Line: line, Err: err}
+		}
+		row.Line = line
+		return row, nil
+	}
+}
+
+// bulkLoader 入稿された行をinsertBatchSize件ずつ1つのトランザクションで書く。
+// ドライバからpgxの接続が取れればCOPY FROMを使い、取れなければ複数行のINSERTにする。
+// upsertはCOPYではできないので常にINSERT ... ON CONFLICTにする
+type bulkLoader struct {
+	table   string
+	columns []string
+	// updateColumns upsertで既存の行を上書きする列。在庫は売り切れのリストと揃える必要があるのでadmin/chair/:id/stockで、
+	// 公開状態はPUT/PATCHで変えるので、ここには含めない
+	updateColumns []string
+	// defaults 入稿のCSVには含めない列の値。公開状態は入稿では変えられない
+	defaults map[string]interface{}
+}
+
+var (
+	chairLoader = bulkLoader{
+		table:         "chair",
+		columns:       []string{"id", "name", "description", "thumbnail", "price", "height", "width", "depth", "color", "features", "kind", "popularity", "stock", "status"},
+		updateColumns: []string{"name", "description", "thumbnail", "price", "height", "width", "depth", "color", "features", "kind", "popularity"},
+		defaults:      map[string]interface{}{"status": StatusActive},
+	}
+	estateLoader = bulkLoader{
+		table:         "estate",
+		columns:       []string{"id", "name", "description", "thumbnail", "address", "latitude", "longitude", "rent", "door_height", "door_width", "features", "popularity", "status"},
+		updateColumns: []string{"name", "description", "thumbnail", "address", "latitude", "longitude", "rent", "door_height", "door_width", "features", "popularity"},
+		defaults:      map[string]interface{}{"status": StatusActive},
+	}
+)
+
+// load nextが返す行を書き、どの行を入れたか、上書きしたか、飛ばしたかを返す。nextはio.EOFを返したら終わり。
+// progressがnilでなければ、バッチを書くたびにそこまでに書いた行と飛ばした行の数を渡す
+func (l bulkLoader) load(ctx context.Context, db *sqlx.DB, mode uploadMode, next func() (uploadRow, error), progress func(done int64)) (*UploadResponse, error) {
+	res := &UploadResponse{
+		InsertedLines: []int{},
+		UpdatedLines:  []int{},
+		RejectedLines: []RejectedLine{},
+	}
+	rows := func() (uploadRow, error) {
+		for {
+			row, err := next()
+			var re *uploadRowError
+			if !errors.As(err, &re) {
+				return row, err
+			}
+			if mode != uploadModeSkipInvalid {
+				return row, newAPIError(http.StatusBadRequest, ErrCodeInvalidUpload, fmt.Sprintf("invalid record at line %d: %v", re.Line, re.Err))
+			}
+			res.RejectedLines = append(res.RejectedLines, RejectedLine{Line: re.Line, Error: re.Err.Error()})
+		}
+	}
+
+	var written int64
+	flushed := func(n int) {
+		written += int64(n)
+		if progress != nil {
+			progress(written + int64(len(res.RejectedLines)))
+		}
+	}
+
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	copied := false
+	if mode != uploadModeUpsert {
+		err = conn.Raw(func(dc interface{}) error {
+			pc, ok := pgxConn(dc)
+			if !ok {
+				return nil
+			}
+			copied = true
+			return l.copyFrom(ctx, pc, rows, flushed, res)
+		})
+	}
+	if err == nil && !copied {
+		err = l.insert(ctx, conn, mode == uploadModeUpsert, rows, flushed, res)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// upsertではバッチの中の順番とRETURNINGの順番が揃わないので並べ直す
+	slices.Sort(res.InsertedLines)
+	slices.Sort(res.UpdatedLines)
+	res.Inserted = int64(len(res.InsertedLines))
+	res.Updated = int64(len(res.UpdatedLines))
+	res.Rejected = int64(len(res.RejectedLines))
+	return res, nil
+}
+
+func (l bulkLoader) batchSize() int {
+	return min(insertBatchSize, maxBindParams/len(l.columns))
+}
+
+func (l bulkLoader) copyFrom(ctx context.Context, conn *pgx.Conn, next func() (uploadRow, error), flushed func(int), res *UploadResponse) error {
+	tx, err := conn.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	err = l.batches(next, func(rows []uploadRow) error {
+		values := make([][]interface{}, 0, len(rows))
+		for _, row := range rows {
+			values = append(values, row.Values)
+		}
+		if _, err := tx.CopyFrom(ctx, pgx.Identifier{l.table}, l.columns, pgx.CopyFromRows(values)); err != nil {
+			return err
+		}
+		for _, row := range rows {
+			res.InsertedLines = append(res.InsertedLines, row.Line)
+		}
+		return nil
+	}, flushed)
+	if err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+func (l bulkLoader) insert(ctx context.Context, conn *sql.Conn, upsert bool, next func() (uploadRow, error), flushed func(int), res *UploadResponse) error {
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	placeholders := "(" + strings.TrimSuffix(strings.Repeat("?, ", len(l.columns)), ", ") + ")"
+	err = l.batches(next, func(rows []uploadRow) error {
+		values := make([]string, 0, len(rows))
+		params := make([]interface{}, 0, len(rows)*len(l.columns))
+		for _, row := range rows {
+			values = append(values, placeholders)
+			params = append(params, row.Values...)
+		}
+		query := fmt.Sprintf("INSERT INTO %s (%s) VALUES %s", l.table, strings.Join(l.columns, ", "), strings.Join(values, ", "))
+		if !upsert {
+			if _, err := tx.ExecContext(ctx, query, params...); err != nil {
+				return err
+			}
+			for _, row := range rows {
+				res.InsertedLines = append(res.InsertedLines, row.Line)
+			}
+			return nil
+		}
+		return l.upsert(ctx, tx, query, params, rows, res)
+	}, flushed)
+	if err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// upsert 既存のidの行はupdateColumnsを上書きしてversionを1増やす。
+// 新しく入った行はxmaxが0になるので、それで入れたのか上書きしたのかを見分ける
+func (l bulkLoader) upsert(ctx context.Context, tx *sql.Tx, query string, params []interface{}, rows []uploadRow, res *UploadResponse) error {
+	sets := make([]string, 0, len(l.updateColumns)+1)
+	for _, col := range l.updateColumns {
+		sets = append(sets, col+" = EXCLUDED."+col)
+	}
+	sets = append(sets, "version = "+l.table+".version + 1")
+	query += " ON CONFLICT (id) DO UPDATE SET " + strings.Join(sets, ", ") + " RETURNING id, (xmax = 0) AS inserted"
+
+	lines := make(map[int64]int, len(rows))
+	for _, row := range rows {
+		lines[row.ID] = row.Line
+	}
+	rs, err := tx.QueryContext(ctx, query, params...)
+	if err != nil {
+		return err
+	}
+	defer rs.Close()
+	for rs.Next() {
+		var id int64
+		var inserted bool
+		if err := rs.Scan(&id, &inserted); err != nil {
+			return err
+		}
+		if inserted {
+			res.InsertedLines = append(res.InsertedLines, lines[id])
+		} else {
+			res.UpdatedLines = append(res.UpdatedLines, lines[id])
+			res.updatedIDs = append(res.updatedIDs, id)
+		}
+	}
+	return rs.Err()
+}
+
+// batches nextの行をbatchSize件ずつflushに渡し、書けたらその件数をflushedに渡す。
+// ON CONFLICTは1つの文で同じ行を2回更新できないので、同じidが来たらそこで区切る
+func (l bulkLoader) batches(next func() (uploadRow, error), flush func([]uploadRow) error, flushed func(int)) error {
+	size := l.batchSize()
+	rows := make([]uploadRow, 0, size)
+	ids := make(map[int64]struct{}, size)
+	write := func() error {
+		if len(rows) == 0 {
+			return nil
+		}
+		if err := flush(rows); err != nil {
+			return err
+		}
+		flushed(len(rows))
+		rows = rows[:0]
+		clear(ids)
+		return nil
+	}
+	for {
+		row, err := next()
+		if errors.Is(err, io.EOF) {
+			return write()
+		}
+		if err != nil {
+			return err
+		}
+		if _, ok := ids[row.ID]; ok {
+			if err := write(); err != nil {
+				return err
+			}
+		}
+		rows = append(rows, row)
+		ids[row.ID] = struct{}{}
+		if len(rows) >= size {
+			if err := write(); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// pgxConn database/sqlのドライバの接続からpgxの接続を取り出す。
+// otelsqlやgo-sql-proxyのラッパーは元の接続をConnフィールドに持つので、それをたどる
+func pgxConn(dc interface{}) (*pgx.Conn, bool) {
+	for dc != nil {
+		if c, ok := dc.(*stdlib.Conn); ok {
+			return c.Conn(), true
+		}
+		v := reflect.ValueOf(dc)
+		if v.Kind() != reflect.Pointer || v.Elem().Kind() != reflect.Struct {
+			return nil, false
+		}
+		f := v.Elem().FieldByName("Conn")
+		if !f.IsValid() || !f.CanInterface() || f.Type() != reflect.TypeOf((*driver.Conn)(nil)).Elem() {
+			return nil, false
+		}
+		dc = f.Interface()
+	}
+	return nil, false
+}
+
+// rowRecord bulkLoaderの列の順の値を、dbタグで対応するTのフィールドに戻す関数を返す
+func rowRecord[T any](l bulkLoader) func(uploadRow) T {
+	typ := reflect.TypeOf((*T)(nil)).Elem()
+	fields := make([]int, len(l.columns))
+	for j, col := range l.columns {
+		fields[j] = -1
+		for i := 0; i < typ.NumField(); i++ {
+			if typ.Field(i).Tag.Get("db") == col {
+				fields[j] = i
+			}
+		}
+	}
+	return func(r uploadRow) T {
+		var v T
+		rv := reflect.ValueOf(&v).Elem()
+		for j, i := range fields {
+			if i >= 0 {
+				f := rv.Field(i)
+				f.Set(reflect.ValueOf(r.Values[j]).Convert(f.Type()))
+			}
+		}
+		return v
+	}
+}
+
+// uploadError loadが止まった理由を返す。行を読むときに返したAPIErrorはそのまま返す
+func uploadError(table string, err error) error {
+	var ae *APIError
+	if errors.As(err, &ae) {
+		return ae
+	}
+	return errInternal(fmt.Errorf("failed to insert %s: %w", table, err))
+}
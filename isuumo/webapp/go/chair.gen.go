@@ -22,7 +22,7 @@ func NewChairSQL() chairSQL {
 }
 
 var chairAllColumns = []string{
-	"`id`", "`name`", "`description`", "`thumbnail`", "`price`", "`height`", "`width`", "`depth`", "`color`", "`features`", "`kind`", "`popularity`", "`stock`", "`features_array`", "`price_range`", "`height_range`", "`width_range`", "`depth_range`",
+	"`id`", "`name`", "`description`", "`thumbnail`", "`price`", "`height`", "`width`", "`depth`", "`color`", "`features`", "`kind`", "`popularity`", "`stock`", "`version`", "`status`", "`features_array`", "`price_range`", "`height_range`", "`width_range`", "`depth_range`",
 }
 
 type chairSelectSQL struct {
@@ -506,6 +506,64 @@ func (q chairSelectSQL) OrderByStock(order sqlla.Order) chairSelectSQL {
 	return q
 }
 
+func (q chairSelectSQL) Version(v int64, exprs ...sqlla.Operator) chairSelectSQL {
+	var op sqlla.Operator
+	if len(exprs) == 0 {
+		op = sqlla.OpEqual
+	} else {
+		op = exprs[0]
+	}
+	where := sqlla.ExprInt64{Value: v, Op: op, Column: q.appendColumnPrefix("`version`")}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q chairSelectSQL) VersionIn(vs ...int64) chairSelectSQL {
+	where := sqlla.ExprMultiInt64{Values: vs, Op: sqlla.MakeInOperator(len(vs)), Column: q.appendColumnPrefix("`version`")}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q chairSelectSQL) OrderByVersion(order sqlla.Order) chairSelectSQL {
+	q.order = " ORDER BY " + q.appendColumnPrefix("`version`")
+	if order == sqlla.Asc {
+		q.order += " ASC"
+	} else {
+		q.order += " DESC"
+	}
+
+	return q
+}
+
+func (q chairSelectSQL) Status(v string, exprs ...sqlla.Operator) chairSelectSQL {
+	var op sqlla.Operator
+	if len(exprs) == 0 {
+		op = sqlla.OpEqual
+	} else {
+		op = exprs[0]
+	}
+	where := sqlla.ExprString{Value: v, Op: op, Column: q.appendColumnPrefix("`status`")}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q chairSelectSQL) StatusIn(vs ...string) chairSelectSQL {
+	where := sqlla.ExprMultiString{Values: vs, Op: sqlla.MakeInOperator(len(vs)), Column: q.appendColumnPrefix("`status`")}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q chairSelectSQL) OrderByStatus(order sqlla.Order) chairSelectSQL {
+	q.order = " ORDER BY " + q.appendColumnPrefix("`status`")
+	if order == sqlla.Asc {
+		q.order += " ASC"
+	} else {
+		q.order += " DESC"
+	}
+
+	return q
+}
+
 func (q chairSelectSQL) FeaturesArray(v string, exprs ...sqlla.Operator) chairSelectSQL {
 	var op sqlla.Operator
 	if len(exprs) == 0 {
@@ -788,6 +846,8 @@ func (q chairSelectSQL) Scan(s sqlla.Scanner) (Chair, error) {
 		&row.Kind,
 		&row.Popularity,
 		&row.Stock,
+		&row.Version,
+		&row.Status,
 		&row.FeaturesArray,
 		&row.PriceRange,
 		&row.HeightRange,
@@ -1109,6 +1169,52 @@ func (q chairUpdateSQL) WhereStockIn(vs ...int64) chairUpdateSQL {
 	return q
 }
 
+func (q chairUpdateSQL) SetVersion(v int64) chairUpdateSQL {
+	q.setMap["`version`"] = v
+	return q
+}
+
+func (q chairUpdateSQL) WhereVersion(v int64, exprs ...sqlla.Operator) chairUpdateSQL {
+	var op sqlla.Operator
+	if len(exprs) == 0 {
+		op = sqlla.OpEqual
+	} else {
+		op = exprs[0]
+	}
+	where := sqlla.ExprInt64{Value: v, Op: op, Column: "`version`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q chairUpdateSQL) WhereVersionIn(vs ...int64) chairUpdateSQL {
+	where := sqlla.ExprMultiInt64{Values: vs, Op: sqlla.MakeInOperator(len(vs)), Column: "`version`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q chairUpdateSQL) SetStatus(v string) chairUpdateSQL {
+	q.setMap["`status`"] = v
+	return q
+}
+
+func (q chairUpdateSQL) WhereStatus(v string, exprs ...sqlla.Operator) chairUpdateSQL {
+	var op sqlla.Operator
+	if len(exprs) == 0 {
+		op = sqlla.OpEqual
+	} else {
+		op = exprs[0]
+	}
+	where := sqlla.ExprString{Value: v, Op: op, Column: "`status`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q chairUpdateSQL) WhereStatusIn(vs ...string) chairUpdateSQL {
+	where := sqlla.ExprMultiString{Values: vs, Op: sqlla.MakeInOperator(len(vs)), Column: "`status`"}
+	q.where = append(q.where, where)
+	return q
+}
+
 func (q chairUpdateSQL) SetFeaturesArray(v string) chairUpdateSQL {
 	q.setMap["`features_array`"] = v
 	return q
@@ -1347,6 +1453,16 @@ func (q chairInsertSQL) ValueStock(v int64) chairInsertSQL {
 	return q
 }
 
+func (q chairInsertSQL) ValueVersion(v int64) chairInsertSQL {
+	q.setMap["`version`"] = v
+	return q
+}
+
+func (q chairInsertSQL) ValueStatus(v string) chairInsertSQL {
+	q.setMap["`status`"] = v
+	return q
+}
+
 func (q chairInsertSQL) ValueFeaturesArray(v string) chairInsertSQL {
 	q.setMap["`features_array`"] = v
 	return q
@@ -1634,6 +1750,36 @@ func (q chairInsertOnDuplicateKeyUpdateSQL) SameOnUpdateStock() chairInsertOnDup
 	return q
 }
 
+func (q chairInsertOnDuplicateKeyUpdateSQL) ValueOnUpdateVersion(v int64) chairInsertOnDuplicateKeyUpdateSQL {
+	q.onDuplicateKeyUpdateMap["`version`"] = v
+	return q
+}
+
+func (q chairInsertOnDuplicateKeyUpdateSQL) RawValueOnUpdateVersion(v sqlla.SetMapRawValue) chairInsertOnDuplicateKeyUpdateSQL {
+	q.onDuplicateKeyUpdateMap["`version`"] = v
+	return q
+}
+
+func (q chairInsertOnDuplicateKeyUpdateSQL) SameOnUpdateVersion() chairInsertOnDuplicateKeyUpdateSQL {
+	q.onDuplicateKeyUpdateMap["`version`"] = sqlla.SetMapRawValue("VALUES(`version`)")
+	return q
+}
+
+func (q chairInsertOnDuplicateKeyUpdateSQL) ValueOnUpdateStatus(v string) chairInsertOnDuplicateKeyUpdateSQL {
+	q.onDuplicateKeyUpdateMap["`status`"] = v
+	return q
+}
+
+func (q chairInsertOnDuplicateKeyUpdateSQL) RawValueOnUpdateStatus(v sqlla.SetMapRawValue) chairInsertOnDuplicateKeyUpdateSQL {
+	q.onDuplicateKeyUpdateMap["`status`"] = v
+	return q
+}
+
+func (q chairInsertOnDuplicateKeyUpdateSQL) SameOnUpdateStatus() chairInsertOnDuplicateKeyUpdateSQL {
+	q.onDuplicateKeyUpdateMap["`status`"] = sqlla.SetMapRawValue("VALUES(`status`)")
+	return q
+}
+
 func (q chairInsertOnDuplicateKeyUpdateSQL) ValueOnUpdateFeaturesArray(v string) chairInsertOnDuplicateKeyUpdateSQL {
 	q.onDuplicateKeyUpdateMap["`features_array`"] = v
 	return q
@@ -2062,6 +2208,42 @@ func (q chairDeleteSQL) StockIn(vs ...int64) chairDeleteSQL {
 	return q
 }
 
+func (q chairDeleteSQL) Version(v int64, exprs ...sqlla.Operator) chairDeleteSQL {
+	var op sqlla.Operator
+	if len(exprs) == 0 {
+		op = sqlla.OpEqual
+	} else {
+		op = exprs[0]
+	}
+	where := sqlla.ExprInt64{Value: v, Op: op, Column: "`version`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q chairDeleteSQL) VersionIn(vs ...int64) chairDeleteSQL {
+	where := sqlla.ExprMultiInt64{Values: vs, Op: sqlla.MakeInOperator(len(vs)), Column: "`version`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q chairDeleteSQL) Status(v string, exprs ...sqlla.Operator) chairDeleteSQL {
+	var op sqlla.Operator
+	if len(exprs) == 0 {
+		op = sqlla.OpEqual
+	} else {
+		op = exprs[0]
+	}
+	where := sqlla.ExprString{Value: v, Op: op, Column: "`status`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q chairDeleteSQL) StatusIn(vs ...string) chairDeleteSQL {
+	where := sqlla.ExprMultiString{Values: vs, Op: sqlla.MakeInOperator(len(vs)), Column: "`status`"}
+	q.where = append(q.where, where)
+	return q
+}
+
 func (q chairDeleteSQL) FeaturesArray(v string, exprs ...sqlla.Operator) chairDeleteSQL {
 	var op sqlla.Operator
 	if len(exprs) == 0 {
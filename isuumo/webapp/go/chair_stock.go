@@ -0,0 +1,136 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+)
+
+// ChairStockRequest admin/chair/:id/stockへのリクエストの形式。stockで在庫を上書きするか、incrementで増減するかのどちらか
+type ChairStockRequest struct {
+	Stock     *int64 `json:"stock" validate:"omitempty,gte=0,lte=1000000"`
+	Increment *int64 `json:"increment" validate:"omitempty,gte=-1000000,lte=1000000"`
+	Reason    string `json:"reason" validate:"max=255"`
+}
+
+type ChairStockResponse struct {
+	ID    int64 `json:"id"`
+	Stock int64 `json:"stock"`
+}
+
+// patchChairStock 椅子の在庫を変える。在庫が戻れば売り切れのリストから外し、nginxのキャッシュも消す
+func patchChairStock(c echo.Context) error {
+	var req ChairStockRequest
+	if err := bindAndValidate(c, &req); err != nil {
+		c.Echo().Logger.Infof("patch chair stock failed : %v", err)
+		return newValidationError(err)
+	}
+	if (req.Stock == nil) == (req.Increment == nil) {
+		return &APIError{
+			Status:  http.StatusBadRequest,
+			Code:    ErrCodeValidationFailed,
+			Message: "request validation failed",
+			Errors:  []FieldError{{Field: "stock", Message: "exactly one of stock or increment is required"}},
+		}
+	}
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.Echo().Logger.Infof("patch chair stock failed : %v", err)
+		return newAPIError(http.StatusBadRequest, ErrCodeInvalidID, "id must be an integer")
+	}
+
+	ctx := c.Request().Context()
+	tx, err := chairDB.BeginTxx(ctx, nil)
+	if err != nil {
+		return errInternal(fmt.Errorf("failed to begin tx : %w", err))
+	}
+	defer tx.Rollback()
+
+	var chair Chair
+	if err := tx.GetContext(ctx, &chair, "SELECT * FROM chair WHERE id = ? FOR UPDATE", id); err != nil {
+		if err == sql.ErrNoRows {
+			return newAPIError(http.StatusNotFound, ErrCodeChairNotFound, fmt.Sprintf("chair %d not found", id))
+		}
+		return errInternal(fmt.Errorf("failed to get chair : %w", err))
+	}
+
+	before := chair.Stock
+	operation, amount := "set", int64(0)
+	if req.Stock != nil {
+		amount = *req.Stock
+		chair.Stock = *req.Stock
+	} else {
+		operation, amount = "increment", *req.Increment
+		chair.Stock += *req.Increment
+	}
+	if chair.Stock < 0 {
+		return newAPIError(http.StatusConflict, ErrCodeInsufficientStock, fmt.Sprintf("chair %d has only %d in stock", id, before))
+	}
+
+	if _, err := NewChairSQL().Update().SetStock(chair.Stock).WhereID(chair.ID).ExecContext(ctx, tx); err != nil {
+		return errInternal(fmt.Errorf("chair stock update failed : %w", err))
+	}
+	_, err = NewChairStockAuditSQL().Insert().
+		ValueChairID(chair.ID).
+		ValueOperation(operation).
+		ValueAmount(amount).
+		ValueStockBefore(before).
+		ValueStockAfter(chair.Stock).
+		ValueReason(req.Reason).
+		ExecContext(ctx, tx)
+	if err != nil {
+		return errInternal(fmt.Errorf("failed to insert chair stock audit : %w", err))
+	}
+
+	// buyChairと同じく、売り切れのリストはコミットの前に直し、コミットできなければ戻す
+	restocked := before <= 0 && chair.Stock > 0
+	soldOut := before > 0 && chair.Stock <= 0
+	if err := updateSoldOutChair(ctx, chair.ID, restocked, soldOut); err != nil {
+		return &APIError{
+			Status:   http.StatusInsufficientStorage,
+			Code:     ErrCodeSoldOutUpdateFailed,
+			Message:  "failed to update sold out chairs",
+			Internal: err,
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		if err := updateSoldOutChair(context.Background(), chair.ID, soldOut, restocked); err != nil {
+			c.Echo().Logger.Errorf("failed to restore sold_out_chair, id: %v : %v", chair.ID, err)
+		}
+		return errInternal(fmt.Errorf("failed to commit tx : %w", err))
+	}
+
+	switch {
+	case restocked:
+		lowPricedChairs.add([]Chair{chair})
+	case soldOut:
+		lowPricedChairs.remove(chair.ID)
+	}
+	// キャッシュはproxy_cache_validの1分で切れるので、消せなくても在庫の変更は取り消さない
+	if err := purgeNginxCache(fmt.Sprintf("/api/chair/%d", chair.ID)); err != nil {
+		c.Echo().Logger.Errorf("failed to purge nginx cache, id: %v : %v", chair.ID, err)
+	}
+
+	return c.JSON(http.StatusOK, ChairStockResponse{ID: chair.ID, Stock: chair.Stock})
+}
+
+// updateSoldOutChair 在庫が戻った椅子を売り切れのリストから外し、在庫がなくなった椅子を加える
+func updateSoldOutChair(ctx context.Context, id int64, restocked, soldOut bool) error {
+	if restocked {
+		if err := rdb.SRem(ctx, soldOutChairKey, id).Err(); err != nil {
+			return fmt.Errorf("failed to remove sold_out_chair from redis, id: %v : %w", id, err)
+		}
+	}
+	if soldOut {
+		if err := rdb.SAdd(ctx, soldOutChairKey, id).Err(); err != nil {
+			return fmt.Errorf("failed to insert sold_out_chair to redis, id: %v : %w", id, err)
+		}
+	}
+	return nil
+}
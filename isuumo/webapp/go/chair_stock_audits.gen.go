@@ -0,0 +1,1274 @@
+// Code generated by github.com/mackee/go-sqlla/v2/cmd/sqlla - DO NOT EDIT.
+package main
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"database/sql"
+	"time"
+
+	"github.com/mackee/go-sqlla/v2"
+)
+
+type chairStockAuditSQL struct {
+	where sqlla.Where
+}
+
+func NewChairStockAuditSQL() chairStockAuditSQL {
+	q := chairStockAuditSQL{}
+	return q
+}
+
+var chairStockAuditAllColumns = []string{
+	"`id`", "`chair_id`", "`operation`", "`amount`", "`stock_before`", "`stock_after`", "`reason`", "`created_at`",
+}
+
+type chairStockAuditSelectSQL struct {
+	chairStockAuditSQL
+	Columns     []string
+	order       string
+	limit       *uint64
+	offset      *uint64
+	tableAlias  string
+	joinClauses []string
+
+	additionalWhereClause     string
+	additionalWhereClauseArgs []interface{}
+
+	groupByColumns []string
+
+	isForUpdate bool
+}
+
+func (q chairStockAuditSQL) Select() chairStockAuditSelectSQL {
+	return chairStockAuditSelectSQL{
+		q,
+		chairStockAuditAllColumns,
+		"",
+		nil,
+		nil,
+		"",
+		nil,
+		"",
+		nil,
+		nil,
+		false,
+	}
+}
+
+func (q chairStockAuditSelectSQL) Or(qs ...chairStockAuditSelectSQL) chairStockAuditSelectSQL {
+	ws := make([]sqlla.Where, 0, len(qs))
+	for _, q := range qs {
+		ws = append(ws, q.where)
+	}
+	q.where = append(q.where, sqlla.ExprOr(ws))
+	return q
+}
+
+func (q chairStockAuditSelectSQL) Limit(l uint64) chairStockAuditSelectSQL {
+	q.limit = &l
+	return q
+}
+
+func (q chairStockAuditSelectSQL) Offset(o uint64) chairStockAuditSelectSQL {
+	q.offset = &o
+	return q
+}
+
+func (q chairStockAuditSelectSQL) ForUpdate() chairStockAuditSelectSQL {
+	q.isForUpdate = true
+	return q
+}
+
+func (q chairStockAuditSelectSQL) TableAlias(alias string) chairStockAuditSelectSQL {
+	q.tableAlias = "`" + alias + "`"
+	return q
+}
+
+func (q chairStockAuditSelectSQL) SetColumns(columns ...string) chairStockAuditSelectSQL {
+	q.Columns = make([]string, 0, len(columns))
+	for _, column := range columns {
+		if strings.ContainsAny(column, "(.`") {
+			q.Columns = append(q.Columns, column)
+		} else {
+			q.Columns = append(q.Columns, "`"+column+"`")
+		}
+	}
+	return q
+}
+
+func (q chairStockAuditSelectSQL) JoinClause(clause string) chairStockAuditSelectSQL {
+	q.joinClauses = append(q.joinClauses, clause)
+	return q
+}
+
+func (q chairStockAuditSelectSQL) AdditionalWhereClause(clause string, args ...interface{}) chairStockAuditSelectSQL {
+	q.additionalWhereClause = clause
+	q.additionalWhereClauseArgs = args
+	return q
+}
+
+func (q chairStockAuditSelectSQL) appendColumnPrefix(column string) string {
+	if q.tableAlias == "" || strings.ContainsAny(column, "(.") {
+		return column
+	}
+	return q.tableAlias + "." + column
+}
+
+func (q chairStockAuditSelectSQL) GroupBy(columns ...string) chairStockAuditSelectSQL {
+	q.groupByColumns = make([]string, 0, len(columns))
+	for _, column := range columns {
+		if strings.ContainsAny(column, "(.`") {
+			q.groupByColumns = append(q.groupByColumns, column)
+		} else {
+			q.groupByColumns = append(q.groupByColumns, "`"+column+"`")
+		}
+	}
+	return q
+}
+
+func (q chairStockAuditSelectSQL) ID(v int64, exprs ...sqlla.Operator) chairStockAuditSelectSQL {
+	var op sqlla.Operator
+	if len(exprs) == 0 {
+		op = sqlla.OpEqual
+	} else {
+		op = exprs[0]
+	}
+	where := sqlla.ExprInt64{Value: v, Op: op, Column: q.appendColumnPrefix("`id`")}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q chairStockAuditSelectSQL) IDIn(vs ...int64) chairStockAuditSelectSQL {
+	where := sqlla.ExprMultiInt64{Values: vs, Op: sqlla.MakeInOperator(len(vs)), Column: q.appendColumnPrefix("`id`")}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q chairStockAuditSelectSQL) OrderByID(order sqlla.Order) chairStockAuditSelectSQL {
+	q.order = " ORDER BY " + q.appendColumnPrefix("`id`")
+	if order == sqlla.Asc {
+		q.order += " ASC"
+	} else {
+		q.order += " DESC"
+	}
+
+	return q
+}
+
+func (q chairStockAuditSelectSQL) ChairID(v int64, exprs ...sqlla.Operator) chairStockAuditSelectSQL {
+	var op sqlla.Operator
+	if len(exprs) == 0 {
+		op = sqlla.OpEqual
+	} else {
+		op = exprs[0]
+	}
+	where := sqlla.ExprInt64{Value: v, Op: op, Column: q.appendColumnPrefix("`chair_id`")}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q chairStockAuditSelectSQL) ChairIDIn(vs ...int64) chairStockAuditSelectSQL {
+	where := sqlla.ExprMultiInt64{Values: vs, Op: sqlla.MakeInOperator(len(vs)), Column: q.appendColumnPrefix("`chair_id`")}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q chairStockAuditSelectSQL) OrderByChairID(order sqlla.Order) chairStockAuditSelectSQL {
+	q.order = " ORDER BY " + q.appendColumnPrefix("`chair_id`")
+	if order == sqlla.Asc {
+		q.order += " ASC"
+	} else {
+		q.order += " DESC"
+	}
+
+	return q
+}
+
+func (q chairStockAuditSelectSQL) Operation(v string, exprs ...sqlla.Operator) chairStockAuditSelectSQL {
+	var op sqlla.Operator
+	if len(exprs) == 0 {
+		op = sqlla.OpEqual
+	} else {
+		op = exprs[0]
+	}
+	where := sqlla.ExprString{Value: v, Op: op, Column: q.appendColumnPrefix("`operation`")}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q chairStockAuditSelectSQL) OperationIn(vs ...string) chairStockAuditSelectSQL {
+	where := sqlla.ExprMultiString{Values: vs, Op: sqlla.MakeInOperator(len(vs)), Column: q.appendColumnPrefix("`operation`")}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q chairStockAuditSelectSQL) OrderByOperation(order sqlla.Order) chairStockAuditSelectSQL {
+	q.order = " ORDER BY " + q.appendColumnPrefix("`operation`")
+	if order == sqlla.Asc {
+		q.order += " ASC"
+	} else {
+		q.order += " DESC"
+	}
+
+	return q
+}
+
+func (q chairStockAuditSelectSQL) Amount(v int64, exprs ...sqlla.Operator) chairStockAuditSelectSQL {
+	var op sqlla.Operator
+	if len(exprs) == 0 {
+		op = sqlla.OpEqual
+	} else {
+		op = exprs[0]
+	}
+	where := sqlla.ExprInt64{Value: v, Op: op, Column: q.appendColumnPrefix("`amount`")}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q chairStockAuditSelectSQL) AmountIn(vs ...int64) chairStockAuditSelectSQL {
+	where := sqlla.ExprMultiInt64{Values: vs, Op: sqlla.MakeInOperator(len(vs)), Column: q.appendColumnPrefix("`amount`")}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q chairStockAuditSelectSQL) OrderByAmount(order sqlla.Order) chairStockAuditSelectSQL {
+	q.order = " ORDER BY " + q.appendColumnPrefix("`amount`")
+	if order == sqlla.Asc {
+		q.order += " ASC"
+	} else {
+		q.order += " DESC"
+	}
+
+	return q
+}
+
+func (q chairStockAuditSelectSQL) StockBefore(v int64, exprs ...sqlla.Operator) chairStockAuditSelectSQL {
+	var op sqlla.Operator
+	if len(exprs) == 0 {
+		op = sqlla.OpEqual
+	} else {
+		op = exprs[0]
+	}
+	where := sqlla.ExprInt64{Value: v, Op: op, Column: q.appendColumnPrefix("`stock_before`")}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q chairStockAuditSelectSQL) StockBeforeIn(vs ...int64) chairStockAuditSelectSQL {
+	where := sqlla.ExprMultiInt64{Values: vs, Op: sqlla.MakeInOperator(len(vs)), Column: q.appendColumnPrefix("`stock_before`")}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q chairStockAuditSelectSQL) OrderByStockBefore(order sqlla.Order) chairStockAuditSelectSQL {
+	q.order = " ORDER BY " + q.appendColumnPrefix("`stock_before`")
+	if order == sqlla.Asc {
+		q.order += " ASC"
+	} else {
+		q.order += " DESC"
+	}
+
+	return q
+}
+
+func (q chairStockAuditSelectSQL) StockAfter(v int64, exprs ...sqlla.Operator) chairStockAuditSelectSQL {
+	var op sqlla.Operator
+	if len(exprs) == 0 {
+		op = sqlla.OpEqual
+	} else {
+		op = exprs[0]
+	}
+	where := sqlla.ExprInt64{Value: v, Op: op, Column: q.appendColumnPrefix("`stock_after`")}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q chairStockAuditSelectSQL) StockAfterIn(vs ...int64) chairStockAuditSelectSQL {
+	where := sqlla.ExprMultiInt64{Values: vs, Op: sqlla.MakeInOperator(len(vs)), Column: q.appendColumnPrefix("`stock_after`")}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q chairStockAuditSelectSQL) OrderByStockAfter(order sqlla.Order) chairStockAuditSelectSQL {
+	q.order = " ORDER BY " + q.appendColumnPrefix("`stock_after`")
+	if order == sqlla.Asc {
+		q.order += " ASC"
+	} else {
+		q.order += " DESC"
+	}
+
+	return q
+}
+
+func (q chairStockAuditSelectSQL) Reason(v string, exprs ...sqlla.Operator) chairStockAuditSelectSQL {
+	var op sqlla.Operator
+	if len(exprs) == 0 {
+		op = sqlla.OpEqual
+	} else {
+		op = exprs[0]
+	}
+	where := sqlla.ExprString{Value: v, Op: op, Column: q.appendColumnPrefix("`reason`")}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q chairStockAuditSelectSQL) ReasonIn(vs ...string) chairStockAuditSelectSQL {
+	where := sqlla.ExprMultiString{Values: vs, Op: sqlla.MakeInOperator(len(vs)), Column: q.appendColumnPrefix("`reason`")}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q chairStockAuditSelectSQL) OrderByReason(order sqlla.Order) chairStockAuditSelectSQL {
+	q.order = " ORDER BY " + q.appendColumnPrefix("`reason`")
+	if order == sqlla.Asc {
+		q.order += " ASC"
+	} else {
+		q.order += " DESC"
+	}
+
+	return q
+}
+
+func (q chairStockAuditSelectSQL) CreatedAt(v time.Time, exprs ...sqlla.Operator) chairStockAuditSelectSQL {
+	var op sqlla.Operator
+	if len(exprs) == 0 {
+		op = sqlla.OpEqual
+	} else {
+		op = exprs[0]
+	}
+	where := sqlla.ExprTime{Value: v, Op: op, Column: q.appendColumnPrefix("`created_at`")}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q chairStockAuditSelectSQL) CreatedAtIn(vs ...time.Time) chairStockAuditSelectSQL {
+	where := sqlla.ExprMultiTime{Values: vs, Op: sqlla.MakeInOperator(len(vs)), Column: q.appendColumnPrefix("`created_at`")}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q chairStockAuditSelectSQL) OrderByCreatedAt(order sqlla.Order) chairStockAuditSelectSQL {
+	q.order = " ORDER BY " + q.appendColumnPrefix("`created_at`")
+	if order == sqlla.Asc {
+		q.order += " ASC"
+	} else {
+		q.order += " DESC"
+	}
+
+	return q
+}
+
+func (q chairStockAuditSelectSQL) ToSql() (string, []interface{}, error) {
+	columns := strings.Join(q.Columns, ", ")
+	wheres, vs, err := q.where.ToSql()
+	if err != nil {
+		return "", nil, err
+	}
+
+	tableName := "chair_stock_audits"
+	if q.tableAlias != "" {
+		tableName = tableName + " AS " + q.tableAlias
+		pcs := make([]string, 0, len(q.Columns))
+		for _, column := range q.Columns {
+			pcs = append(pcs, q.appendColumnPrefix(column))
+		}
+		columns = strings.Join(pcs, ", ")
+	}
+	query := "SELECT " + columns + " FROM " + tableName
+	if len(q.joinClauses) > 0 {
+		jc := strings.Join(q.joinClauses, " ")
+		query += " " + jc
+	}
+	if wheres != "" {
+		query += " WHERE" + wheres
+	}
+	if q.additionalWhereClause != "" {
+		query += " " + q.additionalWhereClause
+		if len(q.additionalWhereClauseArgs) > 0 {
+			vs = append(vs, q.additionalWhereClauseArgs...)
+		}
+	}
+	if len(q.groupByColumns) > 0 {
+		query += " GROUP BY "
+		gbcs := make([]string, 0, len(q.groupByColumns))
+		for _, column := range q.groupByColumns {
+			gbcs = append(gbcs, q.appendColumnPrefix(column))
+		}
+		query += strings.Join(gbcs, ", ")
+	}
+	query += q.order
+	if q.limit != nil {
+		query += " LIMIT " + strconv.FormatUint(*q.limit, 10)
+	}
+	if q.offset != nil {
+		query += " OFFSET " + strconv.FormatUint(*q.offset, 10)
+	}
+
+	if q.isForUpdate {
+		query += " FOR UPDATE"
+	}
+
+	return query + ";", vs, nil
+}
+
+func (q chairStockAuditSelectSQL) Single(db sqlla.DB) (ChairStockAudit, error) {
+	q.Columns = chairStockAuditAllColumns
+	query, args, err := q.ToSql()
+	if err != nil {
+		return ChairStockAudit{}, err
+	}
+
+	row := db.QueryRow(query, args...)
+	return q.Scan(row)
+}
+
+func (q chairStockAuditSelectSQL) SingleContext(ctx context.Context, db sqlla.DB) (ChairStockAudit, error) {
+	q.Columns = chairStockAuditAllColumns
+	query, args, err := q.ToSql()
+	if err != nil {
+		return ChairStockAudit{}, err
+	}
+
+	row := db.QueryRowContext(ctx, query, args...)
+	return q.Scan(row)
+}
+
+func (q chairStockAuditSelectSQL) All(db sqlla.DB) ([]ChairStockAudit, error) {
+	rs := make([]ChairStockAudit, 0, 10)
+	q.Columns = chairStockAuditAllColumns
+	query, args, err := q.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		r, err := q.Scan(rows)
+		if err != nil {
+			return nil, err
+		}
+		rs = append(rs, r)
+	}
+	return rs, nil
+}
+
+func (q chairStockAuditSelectSQL) AllContext(ctx context.Context, db sqlla.DB) ([]ChairStockAudit, error) {
+	rs := make([]ChairStockAudit, 0, 10)
+	q.Columns = chairStockAuditAllColumns
+	query, args, err := q.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		r, err := q.Scan(rows)
+		if err != nil {
+			return nil, err
+		}
+		rs = append(rs, r)
+	}
+	return rs, nil
+}
+
+func (q chairStockAuditSelectSQL) Scan(s sqlla.Scanner) (ChairStockAudit, error) {
+	var row ChairStockAudit
+	err := s.Scan(
+		&row.ID,
+		&row.ChairID,
+		&row.Operation,
+		&row.Amount,
+		&row.StockBefore,
+		&row.StockAfter,
+		&row.Reason,
+		&row.CreatedAt,
+	)
+	return row, err
+}
+
+type chairStockAuditUpdateSQL struct {
+	chairStockAuditSQL
+	setMap  sqlla.SetMap
+	Columns []string
+}
+
+func (q chairStockAuditSQL) Update() chairStockAuditUpdateSQL {
+	return chairStockAuditUpdateSQL{
+		chairStockAuditSQL: q,
+		setMap:             sqlla.SetMap{},
+	}
+}
+
+func (q chairStockAuditUpdateSQL) SetID(v int64) chairStockAuditUpdateSQL {
+	q.setMap["`id`"] = v
+	return q
+}
+
+func (q chairStockAuditUpdateSQL) WhereID(v int64, exprs ...sqlla.Operator) chairStockAuditUpdateSQL {
+	var op sqlla.Operator
+	if len(exprs) == 0 {
+		op = sqlla.OpEqual
+	} else {
+		op = exprs[0]
+	}
+	where := sqlla.ExprInt64{Value: v, Op: op, Column: "`id`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q chairStockAuditUpdateSQL) WhereIDIn(vs ...int64) chairStockAuditUpdateSQL {
+	where := sqlla.ExprMultiInt64{Values: vs, Op: sqlla.MakeInOperator(len(vs)), Column: "`id`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q chairStockAuditUpdateSQL) SetChairID(v int64) chairStockAuditUpdateSQL {
+	q.setMap["`chair_id`"] = v
+	return q
+}
+
+func (q chairStockAuditUpdateSQL) WhereChairID(v int64, exprs ...sqlla.Operator) chairStockAuditUpdateSQL {
+	var op sqlla.Operator
+	if len(exprs) == 0 {
+		op = sqlla.OpEqual
+	} else {
+		op = exprs[0]
+	}
+	where := sqlla.ExprInt64{Value: v, Op: op, Column: "`chair_id`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q chairStockAuditUpdateSQL) WhereChairIDIn(vs ...int64) chairStockAuditUpdateSQL {
+	where := sqlla.ExprMultiInt64{Values: vs, Op: sqlla.MakeInOperator(len(vs)), Column: "`chair_id`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q chairStockAuditUpdateSQL) SetOperation(v string) chairStockAuditUpdateSQL {
+	q.setMap["`operation`"] = v
+	return q
+}
+
+func (q chairStockAuditUpdateSQL) WhereOperation(v string, exprs ...sqlla.Operator) chairStockAuditUpdateSQL {
+	var op sqlla.Operator
+	if len(exprs) == 0 {
+		op = sqlla.OpEqual
+	} else {
+		op = exprs[0]
+	}
+	where := sqlla.ExprString{Value: v, Op: op, Column: "`operation`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q chairStockAuditUpdateSQL) WhereOperationIn(vs ...string) chairStockAuditUpdateSQL {
+	where := sqlla.ExprMultiString{Values: vs, Op: sqlla.MakeInOperator(len(vs)), Column: "`operation`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q chairStockAuditUpdateSQL) SetAmount(v int64) chairStockAuditUpdateSQL {
+	q.setMap["`amount`"] = v
+	return q
+}
+
+func (q chairStockAuditUpdateSQL) WhereAmount(v int64, exprs ...sqlla.Operator) chairStockAuditUpdateSQL {
+	var op sqlla.Operator
+	if len(exprs) == 0 {
+		op = sqlla.OpEqual
+	} else {
+		op = exprs[0]
+	}
+	where := sqlla.ExprInt64{Value: v, Op: op, Column: "`amount`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q chairStockAuditUpdateSQL) WhereAmountIn(vs ...int64) chairStockAuditUpdateSQL {
+	where := sqlla.ExprMultiInt64{Values: vs, Op: sqlla.MakeInOperator(len(vs)), Column: "`amount`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q chairStockAuditUpdateSQL) SetStockBefore(v int64) chairStockAuditUpdateSQL {
+	q.setMap["`stock_before`"] = v
+	return q
+}
+
+func (q chairStockAuditUpdateSQL) WhereStockBefore(v int64, exprs ...sqlla.Operator) chairStockAuditUpdateSQL {
+	var op sqlla.Operator
+	if len(exprs) == 0 {
+		op = sqlla.OpEqual
+	} else {
+		op = exprs[0]
+	}
+	where := sqlla.ExprInt64{Value: v, Op: op, Column: "`stock_before`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q chairStockAuditUpdateSQL) WhereStockBeforeIn(vs ...int64) chairStockAuditUpdateSQL {
+	where := sqlla.ExprMultiInt64{Values: vs, Op: sqlla.MakeInOperator(len(vs)), Column: "`stock_before`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q chairStockAuditUpdateSQL) SetStockAfter(v int64) chairStockAuditUpdateSQL {
+	q.setMap["`stock_after`"] = v
+	return q
+}
+
+func (q chairStockAuditUpdateSQL) WhereStockAfter(v int64, exprs ...sqlla.Operator) chairStockAuditUpdateSQL {
+	var op sqlla.Operator
+	if len(exprs) == 0 {
+		op = sqlla.OpEqual
+	} else {
+		op = exprs[0]
+	}
+	where := sqlla.ExprInt64{Value: v, Op: op, Column: "`stock_after`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q chairStockAuditUpdateSQL) WhereStockAfterIn(vs ...int64) chairStockAuditUpdateSQL {
+	where := sqlla.ExprMultiInt64{Values: vs, Op: sqlla.MakeInOperator(len(vs)), Column: "`stock_after`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q chairStockAuditUpdateSQL) SetReason(v string) chairStockAuditUpdateSQL {
+	q.setMap["`reason`"] = v
+	return q
+}
+
+func (q chairStockAuditUpdateSQL) WhereReason(v string, exprs ...sqlla.Operator) chairStockAuditUpdateSQL {
+	var op sqlla.Operator
+	if len(exprs) == 0 {
+		op = sqlla.OpEqual
+	} else {
+		op = exprs[0]
+	}
+	where := sqlla.ExprString{Value: v, Op: op, Column: "`reason`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q chairStockAuditUpdateSQL) WhereReasonIn(vs ...string) chairStockAuditUpdateSQL {
+	where := sqlla.ExprMultiString{Values: vs, Op: sqlla.MakeInOperator(len(vs)), Column: "`reason`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q chairStockAuditUpdateSQL) SetCreatedAt(v time.Time) chairStockAuditUpdateSQL {
+	q.setMap["`created_at`"] = v
+	return q
+}
+
+func (q chairStockAuditUpdateSQL) WhereCreatedAt(v time.Time, exprs ...sqlla.Operator) chairStockAuditUpdateSQL {
+	var op sqlla.Operator
+	if len(exprs) == 0 {
+		op = sqlla.OpEqual
+	} else {
+		op = exprs[0]
+	}
+	where := sqlla.ExprTime{Value: v, Op: op, Column: "`created_at`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q chairStockAuditUpdateSQL) WhereCreatedAtIn(vs ...time.Time) chairStockAuditUpdateSQL {
+	where := sqlla.ExprMultiTime{Values: vs, Op: sqlla.MakeInOperator(len(vs)), Column: "`created_at`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q chairStockAuditUpdateSQL) ToSql() (string, []interface{}, error) {
+	var err error
+	var s interface{} = ChairStockAudit{}
+	if t, ok := s.(chairStockAuditDefaultUpdateHooker); ok {
+		q, err = t.DefaultUpdateHook(q)
+		if err != nil {
+			return "", []interface{}{}, err
+		}
+	}
+	setColumns, svs, err := q.setMap.ToUpdateSql()
+	if err != nil {
+		return "", []interface{}{}, err
+	}
+	wheres, wvs, err := q.where.ToSql()
+	if err != nil {
+		return "", []interface{}{}, err
+	}
+
+	query := "UPDATE chair_stock_audits SET" + setColumns
+	if wheres != "" {
+		query += " WHERE" + wheres
+	}
+
+	return query + ";", append(svs, wvs...), nil
+}
+func (q chairStockAuditUpdateSQL) Exec(db sqlla.DB) (sql.Result, error) {
+	query, args, err := q.ToSql()
+	if err != nil {
+		return nil, err
+	}
+	return db.Exec(query, args...)
+}
+
+func (q chairStockAuditUpdateSQL) ExecContext(ctx context.Context, db sqlla.DB) (sql.Result, error) {
+	query, args, err := q.ToSql()
+	if err != nil {
+		return nil, err
+	}
+	return db.ExecContext(ctx, query, args...)
+}
+
+type chairStockAuditDefaultUpdateHooker interface {
+	DefaultUpdateHook(chairStockAuditUpdateSQL) (chairStockAuditUpdateSQL, error)
+}
+
+type chairStockAuditInsertSQL struct {
+	chairStockAuditSQL
+	setMap  sqlla.SetMap
+	Columns []string
+}
+
+func (q chairStockAuditSQL) Insert() chairStockAuditInsertSQL {
+	return chairStockAuditInsertSQL{
+		chairStockAuditSQL: q,
+		setMap:             sqlla.SetMap{},
+	}
+}
+
+func (q chairStockAuditInsertSQL) ValueID(v int64) chairStockAuditInsertSQL {
+	q.setMap["`id`"] = v
+	return q
+}
+
+func (q chairStockAuditInsertSQL) ValueChairID(v int64) chairStockAuditInsertSQL {
+	q.setMap["`chair_id`"] = v
+	return q
+}
+
+func (q chairStockAuditInsertSQL) ValueOperation(v string) chairStockAuditInsertSQL {
+	q.setMap["`operation`"] = v
+	return q
+}
+
+func (q chairStockAuditInsertSQL) ValueAmount(v int64) chairStockAuditInsertSQL {
+	q.setMap["`amount`"] = v
+	return q
+}
+
+func (q chairStockAuditInsertSQL) ValueStockBefore(v int64) chairStockAuditInsertSQL {
+	q.setMap["`stock_before`"] = v
+	return q
+}
+
+func (q chairStockAuditInsertSQL) ValueStockAfter(v int64) chairStockAuditInsertSQL {
+	q.setMap["`stock_after`"] = v
+	return q
+}
+
+func (q chairStockAuditInsertSQL) ValueReason(v string) chairStockAuditInsertSQL {
+	q.setMap["`reason`"] = v
+	return q
+}
+
+func (q chairStockAuditInsertSQL) ValueCreatedAt(v time.Time) chairStockAuditInsertSQL {
+	q.setMap["`created_at`"] = v
+	return q
+}
+
+func (q chairStockAuditInsertSQL) ToSql() (string, []interface{}, error) {
+	query, vs, err := q.chairStockAuditInsertSQLToSql()
+	if err != nil {
+		return "", []interface{}{}, err
+	}
+	return query + ";", vs, nil
+}
+
+func (q chairStockAuditInsertSQL) chairStockAuditInsertSQLToSql() (string, []interface{}, error) {
+	var err error
+	var s interface{} = ChairStockAudit{}
+	if t, ok := s.(chairStockAuditDefaultInsertHooker); ok {
+		q, err = t.DefaultInsertHook(q)
+		if err != nil {
+			return "", []interface{}{}, err
+		}
+	}
+	qs, vs, err := q.setMap.ToInsertSql()
+	if err != nil {
+		return "", []interface{}{}, err
+	}
+
+	query := "INSERT INTO chair_stock_audits " + qs
+
+	return query, vs, nil
+}
+
+func (q chairStockAuditInsertSQL) OnDuplicateKeyUpdate() chairStockAuditInsertOnDuplicateKeyUpdateSQL {
+	return chairStockAuditInsertOnDuplicateKeyUpdateSQL{
+		insertSQL:               q,
+		onDuplicateKeyUpdateMap: sqlla.SetMap{},
+	}
+}
+
+func (q chairStockAuditInsertSQL) Exec(db sqlla.DB) (sql.Result, error) {
+	query, args, err := q.ToSql()
+	if err != nil {
+
+		return nil, err
+	}
+	result, err := db.Exec(query, args...)
+	return result, err
+}
+
+func (q chairStockAuditInsertSQL) ExecContext(ctx context.Context, db sqlla.DB) (sql.Result, error) {
+	query, args, err := q.ToSql()
+	if err != nil {
+
+		return nil, err
+	}
+	result, err := db.ExecContext(ctx, query, args...)
+	return result, err
+}
+
+type chairStockAuditDefaultInsertHooker interface {
+	DefaultInsertHook(chairStockAuditInsertSQL) (chairStockAuditInsertSQL, error)
+}
+
+type chairStockAuditInsertSQLToSqler interface {
+	chairStockAuditInsertSQLToSql() (string, []interface{}, error)
+}
+
+type chairStockAuditInsertOnDuplicateKeyUpdateSQL struct {
+	insertSQL               chairStockAuditInsertSQLToSqler
+	onDuplicateKeyUpdateMap sqlla.SetMap
+}
+
+func (q chairStockAuditInsertOnDuplicateKeyUpdateSQL) ValueOnUpdateID(v int64) chairStockAuditInsertOnDuplicateKeyUpdateSQL {
+	q.onDuplicateKeyUpdateMap["`id`"] = v
+	return q
+}
+
+func (q chairStockAuditInsertOnDuplicateKeyUpdateSQL) RawValueOnUpdateID(v sqlla.SetMapRawValue) chairStockAuditInsertOnDuplicateKeyUpdateSQL {
+	q.onDuplicateKeyUpdateMap["`id`"] = v
+	return q
+}
+
+func (q chairStockAuditInsertOnDuplicateKeyUpdateSQL) SameOnUpdateID() chairStockAuditInsertOnDuplicateKeyUpdateSQL {
+	q.onDuplicateKeyUpdateMap["`id`"] = sqlla.SetMapRawValue("VALUES(`id`)")
+	return q
+}
+
+func (q chairStockAuditInsertOnDuplicateKeyUpdateSQL) ValueOnUpdateChairID(v int64) chairStockAuditInsertOnDuplicateKeyUpdateSQL {
+	q.onDuplicateKeyUpdateMap["`chair_id`"] = v
+	return q
+}
+
+func (q chairStockAuditInsertOnDuplicateKeyUpdateSQL) RawValueOnUpdateChairID(v sqlla.SetMapRawValue) chairStockAuditInsertOnDuplicateKeyUpdateSQL {
+	q.onDuplicateKeyUpdateMap["`chair_id`"] = v
+	return q
+}
+
+func (q chairStockAuditInsertOnDuplicateKeyUpdateSQL) SameOnUpdateChairID() chairStockAuditInsertOnDuplicateKeyUpdateSQL {
+	q.onDuplicateKeyUpdateMap["`chair_id`"] = sqlla.SetMapRawValue("VALUES(`chair_id`)")
+	return q
+}
+
+func (q chairStockAuditInsertOnDuplicateKeyUpdateSQL) ValueOnUpdateOperation(v string) chairStockAuditInsertOnDuplicateKeyUpdateSQL {
+	q.onDuplicateKeyUpdateMap["`operation`"] = v
+	return q
+}
+
+func (q chairStockAuditInsertOnDuplicateKeyUpdateSQL) RawValueOnUpdateOperation(v sqlla.SetMapRawValue) chairStockAuditInsertOnDuplicateKeyUpdateSQL {
+	q.onDuplicateKeyUpdateMap["`operation`"] = v
+	return q
+}
+
+func (q chairStockAuditInsertOnDuplicateKeyUpdateSQL) SameOnUpdateOperation() chairStockAuditInsertOnDuplicateKeyUpdateSQL {
+	q.onDuplicateKeyUpdateMap["`operation`"] = sqlla.SetMapRawValue("VALUES(`operation`)")
+	return q
+}
+
+func (q chairStockAuditInsertOnDuplicateKeyUpdateSQL) ValueOnUpdateAmount(v int64) chairStockAuditInsertOnDuplicateKeyUpdateSQL {
+	q.onDuplicateKeyUpdateMap["`amount`"] = v
+	return q
+}
+
+func (q chairStockAuditInsertOnDuplicateKeyUpdateSQL) RawValueOnUpdateAmount(v sqlla.SetMapRawValue) chairStockAuditInsertOnDuplicateKeyUpdateSQL {
+	q.onDuplicateKeyUpdateMap["`amount`"] = v
+	return q
+}
+
+func (q chairStockAuditInsertOnDuplicateKeyUpdateSQL) SameOnUpdateAmount() chairStockAuditInsertOnDuplicateKeyUpdateSQL {
+	q.onDuplicateKeyUpdateMap["`amount`"] = sqlla.SetMapRawValue("VALUES(`amount`)")
+	return q
+}
+
+func (q chairStockAuditInsertOnDuplicateKeyUpdateSQL) ValueOnUpdateStockBefore(v int64) chairStockAuditInsertOnDuplicateKeyUpdateSQL {
+	q.onDuplicateKeyUpdateMap["`stock_before`"] = v
+	return q
+}
+
+func (q chairStockAuditInsertOnDuplicateKeyUpdateSQL) RawValueOnUpdateStockBefore(v sqlla.SetMapRawValue) chairStockAuditInsertOnDuplicateKeyUpdateSQL {
+	q.onDuplicateKeyUpdateMap["`stock_before`"] = v
+	return q
+}
+
+func (q chairStockAuditInsertOnDuplicateKeyUpdateSQL) SameOnUpdateStockBefore() chairStockAuditInsertOnDuplicateKeyUpdateSQL {
+	q.onDuplicateKeyUpdateMap["`stock_before`"] = sqlla.SetMapRawValue("VALUES(`stock_before`)")
+	return q
+}
+
+func (q chairStockAuditInsertOnDuplicateKeyUpdateSQL) ValueOnUpdateStockAfter(v int64) chairStockAuditInsertOnDuplicateKeyUpdateSQL {
+	q.onDuplicateKeyUpdateMap["`stock_after`"] = v
+	return q
+}
+
+func (q chairStockAuditInsertOnDuplicateKeyUpdateSQL) RawValueOnUpdateStockAfter(v sqlla.SetMapRawValue) chairStockAuditInsertOnDuplicateKeyUpdateSQL {
+	q.onDuplicateKeyUpdateMap["`stock_after`"] = v
+	return q
+}
+
+func (q chairStockAuditInsertOnDuplicateKeyUpdateSQL) SameOnUpdateStockAfter() chairStockAuditInsertOnDuplicateKeyUpdateSQL {
+	q.onDuplicateKeyUpdateMap["`stock_after`"] = sqlla.SetMapRawValue("VALUES(`stock_after`)")
+	return q
+}
+
+func (q chairStockAuditInsertOnDuplicateKeyUpdateSQL) ValueOnUpdateReason(v string) chairStockAuditInsertOnDuplicateKeyUpdateSQL {
+	q.onDuplicateKeyUpdateMap["`reason`"] = v
+	return q
+}
+
+func (q chairStockAuditInsertOnDuplicateKeyUpdateSQL) RawValueOnUpdateReason(v sqlla.SetMapRawValue) chairStockAuditInsertOnDuplicateKeyUpdateSQL {
+	q.onDuplicateKeyUpdateMap["`reason`"] = v
+	return q
+}
+
+func (q chairStockAuditInsertOnDuplicateKeyUpdateSQL) SameOnUpdateReason() chairStockAuditInsertOnDuplicateKeyUpdateSQL {
+	q.onDuplicateKeyUpdateMap["`reason`"] = sqlla.SetMapRawValue("VALUES(`reason`)")
+	return q
+}
+
+func (q chairStockAuditInsertOnDuplicateKeyUpdateSQL) ValueOnUpdateCreatedAt(v time.Time) chairStockAuditInsertOnDuplicateKeyUpdateSQL {
+	q.onDuplicateKeyUpdateMap["`created_at`"] = v
+	return q
+}
+
+func (q chairStockAuditInsertOnDuplicateKeyUpdateSQL) RawValueOnUpdateCreatedAt(v sqlla.SetMapRawValue) chairStockAuditInsertOnDuplicateKeyUpdateSQL {
+	q.onDuplicateKeyUpdateMap["`created_at`"] = v
+	return q
+}
+
+func (q chairStockAuditInsertOnDuplicateKeyUpdateSQL) SameOnUpdateCreatedAt() chairStockAuditInsertOnDuplicateKeyUpdateSQL {
+	q.onDuplicateKeyUpdateMap["`created_at`"] = sqlla.SetMapRawValue("VALUES(`created_at`)")
+	return q
+}
+
+func (q chairStockAuditInsertOnDuplicateKeyUpdateSQL) ToSql() (string, []interface{}, error) {
+	var err error
+	var s interface{} = ChairStockAudit{}
+	if t, ok := s.(chairStockAuditDefaultInsertOnDuplicateKeyUpdateHooker); ok {
+		q, err = t.DefaultInsertOnDuplicateKeyUpdateHook(q)
+		if err != nil {
+			return "", []interface{}{}, err
+		}
+	}
+
+	query, vs, err := q.insertSQL.chairStockAuditInsertSQLToSql()
+	if err != nil {
+		return "", []interface{}{}, err
+	}
+
+	os, ovs, err := q.onDuplicateKeyUpdateMap.ToUpdateSql()
+	if err != nil {
+		return "", []interface{}{}, err
+	}
+	query += " ON DUPLICATE KEY UPDATE" + os
+	vs = append(vs, ovs...)
+
+	return query + ";", vs, nil
+}
+
+func (q chairStockAuditInsertOnDuplicateKeyUpdateSQL) ExecContext(ctx context.Context, db sqlla.DB) (sql.Result, error) {
+	query, args, err := q.ToSql()
+	if err != nil {
+
+		return nil, err
+	}
+	result, err := db.ExecContext(ctx, query, args...)
+	return result, err
+}
+
+type chairStockAuditDefaultInsertOnDuplicateKeyUpdateHooker interface {
+	DefaultInsertOnDuplicateKeyUpdateHook(chairStockAuditInsertOnDuplicateKeyUpdateSQL) (chairStockAuditInsertOnDuplicateKeyUpdateSQL, error)
+}
+
+type chairStockAuditBulkInsertSQL struct {
+	insertSQLs []chairStockAuditInsertSQL
+}
+
+func (q chairStockAuditSQL) BulkInsert() *chairStockAuditBulkInsertSQL {
+	return &chairStockAuditBulkInsertSQL{
+		insertSQLs: []chairStockAuditInsertSQL{},
+	}
+}
+
+func (q *chairStockAuditBulkInsertSQL) Append(iqs ...chairStockAuditInsertSQL) {
+	q.insertSQLs = append(q.insertSQLs, iqs...)
+}
+
+func (q *chairStockAuditBulkInsertSQL) chairStockAuditInsertSQLToSql() (string, []interface{}, error) {
+	if len(q.insertSQLs) == 0 {
+		return "", []interface{}{}, fmt.Errorf("sqlla: This chairStockAuditBulkInsertSQL's InsertSQL was empty")
+	}
+	iqs := make([]chairStockAuditInsertSQL, len(q.insertSQLs))
+	copy(iqs, q.insertSQLs)
+
+	var s interface{} = ChairStockAudit{}
+	if t, ok := s.(chairStockAuditDefaultInsertHooker); ok {
+		for i, iq := range iqs {
+			var err error
+			iq, err = t.DefaultInsertHook(iq)
+			if err != nil {
+				return "", []interface{}{}, err
+			}
+			iqs[i] = iq
+		}
+	}
+
+	sms := make(sqlla.SetMaps, 0, len(q.insertSQLs))
+	for _, iq := range q.insertSQLs {
+		sms = append(sms, iq.setMap)
+	}
+
+	query, vs, err := sms.ToInsertSql()
+	if err != nil {
+		return "", []interface{}{}, err
+	}
+
+	return "INSERT INTO `chair_stock_audits` " + query, vs, nil
+}
+
+func (q *chairStockAuditBulkInsertSQL) ToSql() (string, []interface{}, error) {
+	query, vs, err := q.chairStockAuditInsertSQLToSql()
+	if err != nil {
+		return "", []interface{}{}, err
+	}
+	return query + ";", vs, nil
+}
+
+func (q *chairStockAuditBulkInsertSQL) OnDuplicateKeyUpdate() chairStockAuditInsertOnDuplicateKeyUpdateSQL {
+	return chairStockAuditInsertOnDuplicateKeyUpdateSQL{
+		insertSQL:               q,
+		onDuplicateKeyUpdateMap: sqlla.SetMap{},
+	}
+}
+
+func (q *chairStockAuditBulkInsertSQL) ExecContext(ctx context.Context, db sqlla.DB) (sql.Result, error) {
+	query, args, err := q.ToSql()
+	if err != nil {
+		return nil, err
+	}
+	result, err := db.ExecContext(ctx, query, args...)
+	return result, err
+}
+
+type chairStockAuditDeleteSQL struct {
+	chairStockAuditSQL
+}
+
+func (q chairStockAuditSQL) Delete() chairStockAuditDeleteSQL {
+	return chairStockAuditDeleteSQL{
+		q,
+	}
+}
+
+func (q chairStockAuditDeleteSQL) ID(v int64, exprs ...sqlla.Operator) chairStockAuditDeleteSQL {
+	var op sqlla.Operator
+	if len(exprs) == 0 {
+		op = sqlla.OpEqual
+	} else {
+		op = exprs[0]
+	}
+	where := sqlla.ExprInt64{Value: v, Op: op, Column: "`id`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q chairStockAuditDeleteSQL) IDIn(vs ...int64) chairStockAuditDeleteSQL {
+	where := sqlla.ExprMultiInt64{Values: vs, Op: sqlla.MakeInOperator(len(vs)), Column: "`id`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q chairStockAuditDeleteSQL) ChairID(v int64, exprs ...sqlla.Operator) chairStockAuditDeleteSQL {
+	var op sqlla.Operator
+	if len(exprs) == 0 {
+		op = sqlla.OpEqual
+	} else {
+		op = exprs[0]
+	}
+	where := sqlla.ExprInt64{Value: v, Op: op, Column: "`chair_id`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q chairStockAuditDeleteSQL) ChairIDIn(vs ...int64) chairStockAuditDeleteSQL {
+	where := sqlla.ExprMultiInt64{Values: vs, Op: sqlla.MakeInOperator(len(vs)), Column: "`chair_id`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q chairStockAuditDeleteSQL) Operation(v string, exprs ...sqlla.Operator) chairStockAuditDeleteSQL {
+	var op sqlla.Operator
+	if len(exprs) == 0 {
+		op = sqlla.OpEqual
+	} else {
+		op = exprs[0]
+	}
+	where := sqlla.ExprString{Value: v, Op: op, Column: "`operation`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q chairStockAuditDeleteSQL) OperationIn(vs ...string) chairStockAuditDeleteSQL {
+	where := sqlla.ExprMultiString{Values: vs, Op: sqlla.MakeInOperator(len(vs)), Column: "`operation`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q chairStockAuditDeleteSQL) Amount(v int64, exprs ...sqlla.Operator) chairStockAuditDeleteSQL {
+	var op sqlla.Operator
+	if len(exprs) == 0 {
+		op = sqlla.OpEqual
+	} else {
+		op = exprs[0]
+	}
+	where := sqlla.ExprInt64{Value: v, Op: op, Column: "`amount`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q chairStockAuditDeleteSQL) AmountIn(vs ...int64) chairStockAuditDeleteSQL {
+	where := sqlla.ExprMultiInt64{Values: vs, Op: sqlla.MakeInOperator(len(vs)), Column: "`amount`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q chairStockAuditDeleteSQL) StockBefore(v int64, exprs ...sqlla.Operator) chairStockAuditDeleteSQL {
+	var op sqlla.Operator
+	if len(exprs) == 0 {
+		op = sqlla.OpEqual
+	} else {
+		op = exprs[0]
+	}
+	where := sqlla.ExprInt64{Value: v, Op: op, Column: "`stock_before`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q chairStockAuditDeleteSQL) StockBeforeIn(vs ...int64) chairStockAuditDeleteSQL {
+	where := sqlla.ExprMultiInt64{Values: vs, Op: sqlla.MakeInOperator(len(vs)), Column: "`stock_before`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q chairStockAuditDeleteSQL) StockAfter(v int64, exprs ...sqlla.Operator) chairStockAuditDeleteSQL {
+	var op sqlla.Operator
+	if len(exprs) == 0 {
+		op = sqlla.OpEqual
+	} else {
+		op = exprs[0]
+	}
+	where := sqlla.ExprInt64{Value: v, Op: op, Column: "`stock_after`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q chairStockAuditDeleteSQL) StockAfterIn(vs ...int64) chairStockAuditDeleteSQL {
+	where := sqlla.ExprMultiInt64{Values: vs, Op: sqlla.MakeInOperator(len(vs)), Column: "`stock_after`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q chairStockAuditDeleteSQL) Reason(v string, exprs ...sqlla.Operator) chairStockAuditDeleteSQL {
+	var op sqlla.Operator
+	if len(exprs) == 0 {
+		op = sqlla.OpEqual
+	} else {
+		op = exprs[0]
+	}
+	where := sqlla.ExprString{Value: v, Op: op, Column: "`reason`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q chairStockAuditDeleteSQL) ReasonIn(vs ...string) chairStockAuditDeleteSQL {
+	where := sqlla.ExprMultiString{Values: vs, Op: sqlla.MakeInOperator(len(vs)), Column: "`reason`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q chairStockAuditDeleteSQL) CreatedAt(v time.Time, exprs ...sqlla.Operator) chairStockAuditDeleteSQL {
+	var op sqlla.Operator
+	if len(exprs) == 0 {
+		op = sqlla.OpEqual
+	} else {
+		op = exprs[0]
+	}
+	where := sqlla.ExprTime{Value: v, Op: op, Column: "`created_at`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q chairStockAuditDeleteSQL) CreatedAtIn(vs ...time.Time) chairStockAuditDeleteSQL {
+	where := sqlla.ExprMultiTime{Values: vs, Op: sqlla.MakeInOperator(len(vs)), Column: "`created_at`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q chairStockAuditDeleteSQL) ToSql() (string, []interface{}, error) {
+	wheres, vs, err := q.where.ToSql()
+	if err != nil {
+		return "", nil, err
+	}
+
+	query := "DELETE FROM chair_stock_audits"
+	if wheres != "" {
+		query += " WHERE" + wheres
+	}
+
+	return query + ";", vs, nil
+}
+
+func (q chairStockAuditDeleteSQL) Exec(db sqlla.DB) (sql.Result, error) {
+	query, args, err := q.ToSql()
+	if err != nil {
+		return nil, err
+	}
+	return db.Exec(query, args...)
+}
+
+func (q chairStockAuditDeleteSQL) ExecContext(ctx context.Context, db sqlla.DB) (sql.Result, error) {
+	query, args, err := q.ToSql()
+	if err != nil {
+		return nil, err
+	}
+	return db.ExecContext(ctx, query, args...)
+}
@@ -0,0 +1,101 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"syscall"
+
+	"github.com/labstack/echo/v4"
+)
+
+// SearchConditions fixtureから読んだ椅子と物件の検索条件
+type SearchConditions struct {
+	Chair  ChairSearchCondition
+	Estate EstateSearchCondition
+}
+
+var (
+	searchConditions atomic.Pointer[SearchConditions]
+	// reloadMu 再読み込みが並んだときに検証と差し替えの順序が入れ替わらないようにする
+	reloadMu sync.Mutex
+)
+
+// currentSearchConditions 今の検索条件を返す。1リクエストの中では最初に取ったものを使い続ける
+func currentSearchConditions() *SearchConditions {
+	return searchConditions.Load()
+}
+
+func fixtureDir() string {
+	return getEnv("FIXTURE_DIR", "../fixture")
+}
+
+// loadSearchConditions dirのchair_condition.jsonとestate_condition.jsonを読む
+func loadSearchConditions(dir string) (*SearchConditions, error) {
+	var conds SearchConditions
+	if err := readJSONFile(filepath.Join(dir, "chair_condition.json"), &conds.Chair); err != nil {
+		return nil, err
+	}
+	if err := readJSONFile(filepath.Join(dir, "estate_condition.json"), &conds.Estate); err != nil {
+		return nil, err
+	}
+	return &conds, nil
+}
+
+func readJSONFile(path string, v interface{}) error {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(b, v); err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+	return nil
+}
+
+// reloadSearchConditions fixtureを読み直し、DBの *_range 列と合っていれば差し替える。
+// 読めなかったときや合わなかったときは今の検索条件をそのまま使い続ける
+func reloadSearchConditions(ctx context.Context) (*SearchConditions, error) {
+	reloadMu.Lock()
+	defer reloadMu.Unlock()
+
+	conds, err := loadSearchConditions(fixtureDir())
+	if err != nil {
+		return nil, err
+	}
+	if err := verifyRangeBuckets(ctx, conds); err != nil {
+		return nil, err
+	}
+	searchConditions.Store(conds)
+	return conds, nil
+}
+
+// postReloadConditions nginxからは公開していないので、アプリサーバに直接リクエストして使う
+func postReloadConditions(c echo.Context) error {
+	conds, err := reloadSearchConditions(c.Request().Context())
+	if err != nil {
+		return newAPIError(http.StatusUnprocessableEntity, ErrCodeInvalidSearchCondition, err.Error())
+	}
+	return c.JSON(http.StatusOK, conds)
+}
+
+// reloadConditionsOnSIGHUP SIGHUPを受けたら検索条件を読み直す
+func reloadConditionsOnSIGHUP(e *echo.Echo) {
+	ch := make(chan os.Signal, 1)
+	signal.Notify(ch, syscall.SIGHUP)
+	go func() {
+		for range ch {
+			if _, err := reloadSearchConditions(context.Background()); err != nil {
+				e.Logger.Errorf("search conditions reload failed : %v", err)
+				continue
+			}
+			e.Logger.Infof("search conditions reloaded")
+		}
+	}()
+}
@@ -0,0 +1,73 @@
+package main
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+)
+
+// searchCursor 検索結果の最後に返した行の並び順のキー。クライアントには不透明な文字列として渡す
+type searchCursor struct {
+	Sort string `json:"s"`
+	Key  int64  `json:"k"`
+	ID   int64  `json:"i"`
+}
+
+func encodeSearchCursor(sc searchCursor) string {
+	b, _ := json.Marshal(sc)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func decodeSearchCursor(s string) (searchCursor, error) {
+	var sc searchCursor
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return sc, err
+	}
+	if err := json.Unmarshal(b, &sc); err != nil {
+		return sc, err
+	}
+	return sc, nil
+}
+
+// searchCursorParam cursorクエリパラメータを読む。指定されていなければnilを返す。
+// 別の並び順で作られたcursorは続きの位置が決まらないので受け付けない
+func searchCursorParam(c echo.Context, sort string) (*searchCursor, error) {
+	s := c.QueryParam("cursor")
+	if s == "" {
+		return nil, nil
+	}
+	sc, err := decodeSearchCursor(s)
+	if err != nil {
+		c.Logger().Infof("Invalid format cursor parameter : %v", err)
+		return nil, newAPIError(http.StatusBadRequest, ErrCodeInvalidCursor, "cursor is invalid")
+	}
+	if sc.Sort != sort {
+		c.Logger().Infof("Mismatched cursor sort : %v != %v", sc.Sort, sort)
+		return nil, newAPIError(http.StatusBadRequest, ErrCodeInvalidCursor, fmt.Sprintf("cursor was made for sort %q", sc.Sort))
+	}
+	return &sc, nil
+}
+
+// withCountParam withCount=falseなら件数を数えない。指定がなければ数える
+func withCountParam(c echo.Context) (bool, error) {
+	return boolQueryParam(c, "withCount", true)
+}
+
+// boolQueryParam 真偽値のクエリパラメータを読む。指定がなければdefaultValueを返す
+func boolQueryParam(c echo.Context, name string, defaultValue bool) (bool, error) {
+	s := c.QueryParam(name)
+	if s == "" {
+		return defaultValue, nil
+	}
+	v, err := strconv.ParseBool(s)
+	if err != nil {
+		c.Logger().Infof("Invalid format %s parameter : %v", name, err)
+		return false, newAPIError(http.StatusBadRequest, ErrCodeBadRequest, fmt.Sprintf("%s must be a boolean : %q", name, s))
+	}
+	return v, nil
+}
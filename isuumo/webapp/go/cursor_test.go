@@ -0,0 +1,111 @@
+package main
+
+import (
+	"errors"
+	"net/url"
+	"reflect"
+	"testing"
+)
+
+func TestSearchCursorRoundTrip(t *testing.T) {
+	chair := Chair{ID: 42, Price: 15000, Popularity: 300}
+	for _, s := range chairSorts {
+		t.Run(s.name, func(t *testing.T) {
+			next := s.nextCursor(chair, chair.ID)
+			sc, err := s.cursorParam(newTestContext("cursor=" + url.QueryEscape(next)))
+			if err != nil {
+				t.Fatalf("cursorParam() error = %v", err)
+			}
+			want := searchCursor{Sort: s.name, ID: chair.ID}
+			if s.key != nil {
+				want.Key = s.key(chair)
+			}
+			if sc == nil || *sc != want {
+				t.Errorf("cursorParam() = %+v, want %+v", sc, want)
+			}
+		})
+	}
+}
+
+func TestSearchCursorParam(t *testing.T) {
+	tests := []struct {
+		name     string
+		query    string
+		want     *searchCursor
+		wantCode ErrorCode
+	}{
+		{name: "no cursor"},
+		{
+			name:  "valid",
+			query: "cursor=" + encodeSearchCursor(searchCursor{Sort: "price", Key: 100, ID: 7}),
+			want:  &searchCursor{Sort: "price", Key: 100, ID: 7},
+		},
+		{
+			name:     "not base64",
+			query:    "cursor=%25%25",
+			wantCode: ErrCodeInvalidCursor,
+		},
+		{
+			name:     "not json",
+			query:    "cursor=" + url.QueryEscape("bm90IGpzb24"),
+			wantCode: ErrCodeInvalidCursor,
+		},
+		{
+			name:     "made for another sort",
+			query:    "cursor=" + encodeSearchCursor(searchCursor{Sort: "-price", Key: 100, ID: 7}),
+			wantCode: ErrCodeInvalidCursor,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sc, err := searchCursorParam(newTestContext(tt.query), "price")
+			if tt.wantCode != "" {
+				var ae *APIError
+				if !errors.As(err, &ae) || ae.Code != tt.wantCode {
+					t.Fatalf("searchCursorParam() error = %v, want code %s", err, tt.wantCode)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("searchCursorParam() error = %v", err)
+			}
+			if !reflect.DeepEqual(sc, tt.want) {
+				t.Errorf("searchCursorParam() = %+v, want %+v", sc, tt.want)
+			}
+		})
+	}
+}
+
+func TestCursorCondition(t *testing.T) {
+	sc := &searchCursor{Key: 100, ID: 7}
+	tests := []struct {
+		sort          string
+		wantCondition string
+		wantParams    []interface{}
+	}{
+		{sort: "price", wantCondition: "(price, id) > (?, ?)", wantParams: []interface{}{int64(100), int64(7)}},
+		{sort: "-price", wantCondition: "price <= ? AND (price < ? OR id > ?)", wantParams: []interface{}{int64(100), int64(100), int64(7)}},
+		{sort: "newest", wantCondition: "id < ?", wantParams: []interface{}{int64(7)}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.sort, func(t *testing.T) {
+			s, _ := sortByName(chairSorts, tt.sort)
+			cond, params := s.cursorCondition(sc)
+			if cond != tt.wantCondition || !reflect.DeepEqual(params, tt.wantParams) {
+				t.Errorf("cursorCondition() = %q %v, want %q %v", cond, params, tt.wantCondition, tt.wantParams)
+			}
+		})
+	}
+}
+
+func TestRelevanceSortCursor(t *testing.T) {
+	s := relevanceSort[Chair]("椅子")
+	if next := s.nextCursor(Chair{ID: 1}, 1); next != "" {
+		t.Errorf("nextCursor() = %q, want empty", next)
+	}
+	_, err := s.cursorParam(newTestContext("cursor=" + encodeSearchCursor(searchCursor{Sort: "relevance", ID: 1})))
+	var ae *APIError
+	if !errors.As(err, &ae) || ae.Code != ErrCodeInvalidCursor {
+		t.Errorf("cursorParam() error = %v, want code %s", err, ErrCodeInvalidCursor)
+	}
+}
@@ -0,0 +1,101 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/mackee/go-sqlla/v2"
+)
+
+// DefaultDocumentRequestDedupeWindow 資料請求をまとめる期間の既定値。DOCUMENT_REQUEST_DEDUPE_WINDOWで変えられる
+const DefaultDocumentRequestDedupeWindow = time.Hour
+
+// documentRequestDedupeWindow 同じemailから同じ物件への資料請求をまとめる期間。0なら毎回記録する
+var documentRequestDedupeWindow = DefaultDocumentRequestDedupeWindow
+
+// documentRequestDedupeWindowFromEnv DOCUMENT_REQUEST_DEDUPE_WINDOWを読む。起動時に呼び、不正な値なら起動しない
+func documentRequestDedupeWindowFromEnv() (time.Duration, error) {
+	s := getEnv("DOCUMENT_REQUEST_DEDUPE_WINDOW", DefaultDocumentRequestDedupeWindow.String())
+	d, err := time.ParseDuration(s)
+	if err != nil || d < 0 {
+		return 0, fmt.Errorf("DOCUMENT_REQUEST_DEDUPE_WINDOW must be a non-negative duration such as 1h or 0s : %q", s)
+	}
+	return d, nil
+}
+
+// insertDocumentRequest 資料請求を記録する。dedupe期間内に同じemailからの請求があれば何もしない
+func insertDocumentRequest(ctx context.Context, estateID int64, email string) error {
+	if documentRequestDedupeWindow == 0 {
+		_, err := NewDocumentRequestSQL().Insert().
+			ValueEstateID(estateID).
+			ValueEmail(email).
+			ExecContext(ctx, estateDB)
+		return err
+	}
+
+	tx, err := estateDB.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	// 同時に届いた同じ請求がどちらも既存の行を見つけられずに入らないよう、物件とemailごとに直列にする。
+	// ロックはコミットまで持つので、あとから取った方の文は先にコミットされた行を見る
+	key := fmt.Sprintf("document_requests:%d:%s", estateID, email)
+	if _, err := tx.ExecContext(ctx, "SELECT pg_advisory_xact_lock(hashtext(?))", key); err != nil {
+		return err
+	}
+	query := `INSERT INTO document_requests (estate_id, email)
+SELECT ?, ? WHERE NOT EXISTS (
+	SELECT 1 FROM document_requests WHERE estate_id = ? AND email = ? AND created_at > CURRENT_TIMESTAMP - make_interval(secs => ?)
+)`
+	if _, err := tx.ExecContext(ctx, query, estateID, email, estateID, email, documentRequestDedupeWindow.Seconds()); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// getEstateDocumentRequests 物件ごとの資料請求を新しい順に返す。cursorには前のページのnextCursorを渡す。
+// 請求した人のemailを含むので、ルートでrequireAdminTokenを付けて管理用のトークンがあるときだけ返す
+func getEstateDocumentRequests(c echo.Context) error {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.Echo().Logger.Infof("Request parameter \"id\" parse error : %v", err)
+		return newAPIError(http.StatusBadRequest, ErrCodeInvalidID, "id must be an integer")
+	}
+
+	q := NewDocumentRequestSQL().Select().EstateID(int64(id))
+	if cursor := c.QueryParam("cursor"); cursor != "" {
+		lastID, err := strconv.ParseInt(cursor, 10, 64)
+		if err != nil {
+			c.Echo().Logger.Infof("Invalid format cursor parameter : %v", err)
+			return newAPIError(http.StatusBadRequest, ErrCodeInvalidCursor, "cursor is invalid")
+		}
+		q = q.ID(lastID, sqlla.OpLess)
+	}
+
+	perPage, err := perPageParam(c)
+	if err != nil {
+		return err
+	}
+
+	// 次のページがあるかを知るために1件多く取る
+	ctx := c.Request().Context()
+	requests, err := q.OrderByID(sqlla.Desc).Limit(uint64(perPage)+1).AllContext(ctx, estateDB)
+	if err != nil {
+		return errInternal(fmt.Errorf("getEstateDocumentRequests DB execution error : %w", err))
+	}
+
+	var res DocumentRequestListResponse
+	if len(requests) > perPage {
+		requests = requests[:perPage]
+		res.NextCursor = strconv.FormatInt(requests[len(requests)-1].ID, 10)
+	}
+	res.DocumentRequests = requests
+
+	return c.JSON(http.StatusOK, res)
+}
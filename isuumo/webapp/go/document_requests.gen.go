@@ -0,0 +1,910 @@
+// Code generated by github.com/mackee/go-sqlla/v2/cmd/sqlla - DO NOT EDIT.
+package main
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"database/sql"
+	"time"
+
+	"github.com/mackee/go-sqlla/v2"
+)
+
+type documentRequestSQL struct {
+	where sqlla.Where
+}
+
+func NewDocumentRequestSQL() documentRequestSQL {
+	q := documentRequestSQL{}
+	return q
+}
+
+var documentRequestAllColumns = []string{
+	"`id`", "`estate_id`", "`email`", "`created_at`",
+}
+
+type documentRequestSelectSQL struct {
+	documentRequestSQL
+	Columns     []string
+	order       string
+	limit       *uint64
+	offset      *uint64
+	tableAlias  string
+	joinClauses []string
+
+	additionalWhereClause     string
+	additionalWhereClauseArgs []interface{}
+
+	groupByColumns []string
+
+	isForUpdate bool
+}
+
+func (q documentRequestSQL) Select() documentRequestSelectSQL {
+	return documentRequestSelectSQL{
+		q,
+		documentRequestAllColumns,
+		"",
+		nil,
+		nil,
+		"",
+		nil,
+		"",
+		nil,
+		nil,
+		false,
+	}
+}
+
+func (q documentRequestSelectSQL) Or(qs ...documentRequestSelectSQL) documentRequestSelectSQL {
+	ws := make([]sqlla.Where, 0, len(qs))
+	for _, q := range qs {
+		ws = append(ws, q.where)
+	}
+	q.where = append(q.where, sqlla.ExprOr(ws))
+	return q
+}
+
+func (q documentRequestSelectSQL) Limit(l uint64) documentRequestSelectSQL {
+	q.limit = &l
+	return q
+}
+
+func (q documentRequestSelectSQL) Offset(o uint64) documentRequestSelectSQL {
+	q.offset = &o
+	return q
+}
+
+func (q documentRequestSelectSQL) ForUpdate() documentRequestSelectSQL {
+	q.isForUpdate = true
+	return q
+}
+
+func (q documentRequestSelectSQL) TableAlias(alias string) documentRequestSelectSQL {
+	q.tableAlias = "`" + alias + "`"
+	return q
+}
+
+func (q documentRequestSelectSQL) SetColumns(columns ...string) documentRequestSelectSQL {
+	q.Columns = make([]string, 0, len(columns))
+	for _, column := range columns {
+		if strings.ContainsAny(column, "(.`") {
+			q.Columns = append(q.Columns, column)
+		} else {
+			q.Columns = append(q.Columns, "`"+column+"`")
+		}
+	}
+	return q
+}
+
+func (q documentRequestSelectSQL) JoinClause(clause string) documentRequestSelectSQL {
+	q.joinClauses = append(q.joinClauses, clause)
+	return q
+}
+
+func (q documentRequestSelectSQL) AdditionalWhereClause(clause string, args ...interface{}) documentRequestSelectSQL {
+	q.additionalWhereClause = clause
+	q.additionalWhereClauseArgs = args
+	return q
+}
+
+func (q documentRequestSelectSQL) appendColumnPrefix(column string) string {
+	if q.tableAlias == "" || strings.ContainsAny(column, "(.") {
+		return column
+	}
+	return q.tableAlias + "." + column
+}
+
+func (q documentRequestSelectSQL) GroupBy(columns ...string) documentRequestSelectSQL {
+	q.groupByColumns = make([]string, 0, len(columns))
+	for _, column := range columns {
+		if strings.ContainsAny(column, "(.`") {
+			q.groupByColumns = append(q.groupByColumns, column)
+		} else {
+			q.groupByColumns = append(q.groupByColumns, "`"+column+"`")
+		}
+	}
+	return q
+}
+
+func (q documentRequestSelectSQL) ID(v int64, exprs ...sqlla.Operator) documentRequestSelectSQL {
+	var op sqlla.Operator
+	if len(exprs) == 0 {
+		op = sqlla.OpEqual
+	} else {
+		op = exprs[0]
+	}
+	where := sqlla.ExprInt64{Value: v, Op: op, Column: q.appendColumnPrefix("`id`")}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q documentRequestSelectSQL) IDIn(vs ...int64) documentRequestSelectSQL {
+	where := sqlla.ExprMultiInt64{Values: vs, Op: sqlla.MakeInOperator(len(vs)), Column: q.appendColumnPrefix("`id`")}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q documentRequestSelectSQL) OrderByID(order sqlla.Order) documentRequestSelectSQL {
+	q.order = " ORDER BY " + q.appendColumnPrefix("`id`")
+	if order == sqlla.Asc {
+		q.order += " ASC"
+	} else {
+		q.order += " DESC"
+	}
+
+	return q
+}
+
+func (q documentRequestSelectSQL) EstateID(v int64, exprs ...sqlla.Operator) documentRequestSelectSQL {
+	var op sqlla.Operator
+	if len(exprs) == 0 {
+		op = sqlla.OpEqual
+	} else {
+		op = exprs[0]
+	}
+	where := sqlla.ExprInt64{Value: v, Op: op, Column: q.appendColumnPrefix("`estate_id`")}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q documentRequestSelectSQL) EstateIDIn(vs ...int64) documentRequestSelectSQL {
+	where := sqlla.ExprMultiInt64{Values: vs, Op: sqlla.MakeInOperator(len(vs)), Column: q.appendColumnPrefix("`estate_id`")}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q documentRequestSelectSQL) OrderByEstateID(order sqlla.Order) documentRequestSelectSQL {
+	q.order = " ORDER BY " + q.appendColumnPrefix("`estate_id`")
+	if order == sqlla.Asc {
+		q.order += " ASC"
+	} else {
+		q.order += " DESC"
+	}
+
+	return q
+}
+
+func (q documentRequestSelectSQL) Email(v string, exprs ...sqlla.Operator) documentRequestSelectSQL {
+	var op sqlla.Operator
+	if len(exprs) == 0 {
+		op = sqlla.OpEqual
+	} else {
+		op = exprs[0]
+	}
+	where := sqlla.ExprString{Value: v, Op: op, Column: q.appendColumnPrefix("`email`")}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q documentRequestSelectSQL) EmailIn(vs ...string) documentRequestSelectSQL {
+	where := sqlla.ExprMultiString{Values: vs, Op: sqlla.MakeInOperator(len(vs)), Column: q.appendColumnPrefix("`email`")}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q documentRequestSelectSQL) OrderByEmail(order sqlla.Order) documentRequestSelectSQL {
+	q.order = " ORDER BY " + q.appendColumnPrefix("`email`")
+	if order == sqlla.Asc {
+		q.order += " ASC"
+	} else {
+		q.order += " DESC"
+	}
+
+	return q
+}
+
+func (q documentRequestSelectSQL) CreatedAt(v time.Time, exprs ...sqlla.Operator) documentRequestSelectSQL {
+	var op sqlla.Operator
+	if len(exprs) == 0 {
+		op = sqlla.OpEqual
+	} else {
+		op = exprs[0]
+	}
+	where := sqlla.ExprTime{Value: v, Op: op, Column: q.appendColumnPrefix("`created_at`")}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q documentRequestSelectSQL) CreatedAtIn(vs ...time.Time) documentRequestSelectSQL {
+	where := sqlla.ExprMultiTime{Values: vs, Op: sqlla.MakeInOperator(len(vs)), Column: q.appendColumnPrefix("`created_at`")}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q documentRequestSelectSQL) OrderByCreatedAt(order sqlla.Order) documentRequestSelectSQL {
+	q.order = " ORDER BY " + q.appendColumnPrefix("`created_at`")
+	if order == sqlla.Asc {
+		q.order += " ASC"
+	} else {
+		q.order += " DESC"
+	}
+
+	return q
+}
+
+func (q documentRequestSelectSQL) ToSql() (string, []interface{}, error) {
+	columns := strings.Join(q.Columns, ", ")
+	wheres, vs, err := q.where.ToSql()
+	if err != nil {
+		return "", nil, err
+	}
+
+	tableName := "document_requests"
+	if q.tableAlias != "" {
+		tableName = tableName + " AS " + q.tableAlias
+		pcs := make([]string, 0, len(q.Columns))
+		for _, column := range q.Columns {
+			pcs = append(pcs, q.appendColumnPrefix(column))
+		}
+		columns = strings.Join(pcs, ", ")
+	}
+	query := "SELECT " + columns + " FROM " + tableName
+	if len(q.joinClauses) > 0 {
+		jc := strings.Join(q.joinClauses, " ")
+		query += " " + jc
+	}
+	if wheres != "" {
+		query += " WHERE" + wheres
+	}
+	if q.additionalWhereClause != "" {
+		query += " " + q.additionalWhereClause
+		if len(q.additionalWhereClauseArgs) > 0 {
+			vs = append(vs, q.additionalWhereClauseArgs...)
+		}
+	}
+	if len(q.groupByColumns) > 0 {
+		query += " GROUP BY "
+		gbcs := make([]string, 0, len(q.groupByColumns))
+		for _, column := range q.groupByColumns {
+			gbcs = append(gbcs, q.appendColumnPrefix(column))
+		}
+		query += strings.Join(gbcs, ", ")
+	}
+	query += q.order
+	if q.limit != nil {
+		query += " LIMIT " + strconv.FormatUint(*q.limit, 10)
+	}
+	if q.offset != nil {
+		query += " OFFSET " + strconv.FormatUint(*q.offset, 10)
+	}
+
+	if q.isForUpdate {
+		query += " FOR UPDATE"
+	}
+
+	return query + ";", vs, nil
+}
+
+func (q documentRequestSelectSQL) Single(db sqlla.DB) (DocumentRequest, error) {
+	q.Columns = documentRequestAllColumns
+	query, args, err := q.ToSql()
+	if err != nil {
+		return DocumentRequest{}, err
+	}
+
+	row := db.QueryRow(query, args...)
+	return q.Scan(row)
+}
+
+func (q documentRequestSelectSQL) SingleContext(ctx context.Context, db sqlla.DB) (DocumentRequest, error) {
+	q.Columns = documentRequestAllColumns
+	query, args, err := q.ToSql()
+	if err != nil {
+		return DocumentRequest{}, err
+	}
+
+	row := db.QueryRowContext(ctx, query, args...)
+	return q.Scan(row)
+}
+
+func (q documentRequestSelectSQL) All(db sqlla.DB) ([]DocumentRequest, error) {
+	rs := make([]DocumentRequest, 0, 10)
+	q.Columns = documentRequestAllColumns
+	query, args, err := q.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		r, err := q.Scan(rows)
+		if err != nil {
+			return nil, err
+		}
+		rs = append(rs, r)
+	}
+	return rs, nil
+}
+
+func (q documentRequestSelectSQL) AllContext(ctx context.Context, db sqlla.DB) ([]DocumentRequest, error) {
+	rs := make([]DocumentRequest, 0, 10)
+	q.Columns = documentRequestAllColumns
+	query, args, err := q.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		r, err := q.Scan(rows)
+		if err != nil {
+			return nil, err
+		}
+		rs = append(rs, r)
+	}
+	return rs, nil
+}
+
+func (q documentRequestSelectSQL) Scan(s sqlla.Scanner) (DocumentRequest, error) {
+	var row DocumentRequest
+	err := s.Scan(
+		&row.ID,
+		&row.EstateID,
+		&row.Email,
+		&row.CreatedAt,
+	)
+	return row, err
+}
+
+type documentRequestUpdateSQL struct {
+	documentRequestSQL
+	setMap  sqlla.SetMap
+	Columns []string
+}
+
+func (q documentRequestSQL) Update() documentRequestUpdateSQL {
+	return documentRequestUpdateSQL{
+		documentRequestSQL: q,
+		setMap:             sqlla.SetMap{},
+	}
+}
+
+func (q documentRequestUpdateSQL) SetID(v int64) documentRequestUpdateSQL {
+	q.setMap["`id`"] = v
+	return q
+}
+
+func (q documentRequestUpdateSQL) WhereID(v int64, exprs ...sqlla.Operator) documentRequestUpdateSQL {
+	var op sqlla.Operator
+	if len(exprs) == 0 {
+		op = sqlla.OpEqual
+	} else {
+		op = exprs[0]
+	}
+	where := sqlla.ExprInt64{Value: v, Op: op, Column: "`id`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q documentRequestUpdateSQL) WhereIDIn(vs ...int64) documentRequestUpdateSQL {
+	where := sqlla.ExprMultiInt64{Values: vs, Op: sqlla.MakeInOperator(len(vs)), Column: "`id`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q documentRequestUpdateSQL) SetEstateID(v int64) documentRequestUpdateSQL {
+	q.setMap["`estate_id`"] = v
+	return q
+}
+
+func (q documentRequestUpdateSQL) WhereEstateID(v int64, exprs ...sqlla.Operator) documentRequestUpdateSQL {
+	var op sqlla.Operator
+	if len(exprs) == 0 {
+		op = sqlla.OpEqual
+	} else {
+		op = exprs[0]
+	}
+	where := sqlla.ExprInt64{Value: v, Op: op, Column: "`estate_id`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q documentRequestUpdateSQL) WhereEstateIDIn(vs ...int64) documentRequestUpdateSQL {
+	where := sqlla.ExprMultiInt64{Values: vs, Op: sqlla.MakeInOperator(len(vs)), Column: "`estate_id`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q documentRequestUpdateSQL) SetEmail(v string) documentRequestUpdateSQL {
+	q.setMap["`email`"] = v
+	return q
+}
+
+func (q documentRequestUpdateSQL) WhereEmail(v string, exprs ...sqlla.Operator) documentRequestUpdateSQL {
+	var op sqlla.Operator
+	if len(exprs) == 0 {
+		op = sqlla.OpEqual
+	} else {
+		op = exprs[0]
+	}
+	where := sqlla.ExprString{Value: v, Op: op, Column: "`email`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q documentRequestUpdateSQL) WhereEmailIn(vs ...string) documentRequestUpdateSQL {
+	where := sqlla.ExprMultiString{Values: vs, Op: sqlla.MakeInOperator(len(vs)), Column: "`email`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q documentRequestUpdateSQL) SetCreatedAt(v time.Time) documentRequestUpdateSQL {
+	q.setMap["`created_at`"] = v
+	return q
+}
+
+func (q documentRequestUpdateSQL) WhereCreatedAt(v time.Time, exprs ...sqlla.Operator) documentRequestUpdateSQL {
+	var op sqlla.Operator
+	if len(exprs) == 0 {
+		op = sqlla.OpEqual
+	} else {
+		op = exprs[0]
+	}
+	where := sqlla.ExprTime{Value: v, Op: op, Column: "`created_at`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q documentRequestUpdateSQL) WhereCreatedAtIn(vs ...time.Time) documentRequestUpdateSQL {
+	where := sqlla.ExprMultiTime{Values: vs, Op: sqlla.MakeInOperator(len(vs)), Column: "`created_at`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q documentRequestUpdateSQL) ToSql() (string, []interface{}, error) {
+	var err error
+	var s interface{} = DocumentRequest{}
+	if t, ok := s.(documentRequestDefaultUpdateHooker); ok {
+		q, err = t.DefaultUpdateHook(q)
+		if err != nil {
+			return "", []interface{}{}, err
+		}
+	}
+	setColumns, svs, err := q.setMap.ToUpdateSql()
+	if err != nil {
+		return "", []interface{}{}, err
+	}
+	wheres, wvs, err := q.where.ToSql()
+	if err != nil {
+		return "", []interface{}{}, err
+	}
+
+	query := "UPDATE document_requests SET" + setColumns
+	if wheres != "" {
+		query += " WHERE" + wheres
+	}
+
+	return query + ";", append(svs, wvs...), nil
+}
+func (q documentRequestUpdateSQL) Exec(db sqlla.DB) (sql.Result, error) {
+	query, args, err := q.ToSql()
+	if err != nil {
+		return nil, err
+	}
+	return db.Exec(query, args...)
+}
+
+func (q documentRequestUpdateSQL) ExecContext(ctx context.Context, db sqlla.DB) (sql.Result, error) {
+	query, args, err := q.ToSql()
+	if err != nil {
+		return nil, err
+	}
+	return db.ExecContext(ctx, query, args...)
+}
+
+type documentRequestDefaultUpdateHooker interface {
+	DefaultUpdateHook(documentRequestUpdateSQL) (documentRequestUpdateSQL, error)
+}
+
+type documentRequestInsertSQL struct {
+	documentRequestSQL
+	setMap  sqlla.SetMap
+	Columns []string
+}
+
+func (q documentRequestSQL) Insert() documentRequestInsertSQL {
+	return documentRequestInsertSQL{
+		documentRequestSQL: q,
+		setMap:             sqlla.SetMap{},
+	}
+}
+
+func (q documentRequestInsertSQL) ValueID(v int64) documentRequestInsertSQL {
+	q.setMap["`id`"] = v
+	return q
+}
+
+func (q documentRequestInsertSQL) ValueEstateID(v int64) documentRequestInsertSQL {
+	q.setMap["`estate_id`"] = v
+	return q
+}
+
+func (q documentRequestInsertSQL) ValueEmail(v string) documentRequestInsertSQL {
+	q.setMap["`email`"] = v
+	return q
+}
+
+func (q documentRequestInsertSQL) ValueCreatedAt(v time.Time) documentRequestInsertSQL {
+	q.setMap["`created_at`"] = v
+	return q
+}
+
+func (q documentRequestInsertSQL) ToSql() (string, []interface{}, error) {
+	query, vs, err := q.documentRequestInsertSQLToSql()
+	if err != nil {
+		return "", []interface{}{}, err
+	}
+	return query + ";", vs, nil
+}
+
+func (q documentRequestInsertSQL) documentRequestInsertSQLToSql() (string, []interface{}, error) {
+	var err error
+	var s interface{} = DocumentRequest{}
+	if t, ok := s.(documentRequestDefaultInsertHooker); ok {
+		q, err = t.DefaultInsertHook(q)
+		if err != nil {
+			return "", []interface{}{}, err
+		}
+	}
+	qs, vs, err := q.setMap.ToInsertSql()
+	if err != nil {
+		return "", []interface{}{}, err
+	}
+
+	query := "INSERT INTO document_requests " + qs
+
+	return query, vs, nil
+}
+
+func (q documentRequestInsertSQL) OnDuplicateKeyUpdate() documentRequestInsertOnDuplicateKeyUpdateSQL {
+	return documentRequestInsertOnDuplicateKeyUpdateSQL{
+		insertSQL:               q,
+		onDuplicateKeyUpdateMap: sqlla.SetMap{},
+	}
+}
+
+func (q documentRequestInsertSQL) Exec(db sqlla.DB) (sql.Result, error) {
+	query, args, err := q.ToSql()
+	if err != nil {
+
+		return nil, err
+	}
+	result, err := db.Exec(query, args...)
+	return result, err
+}
+
+func (q documentRequestInsertSQL) ExecContext(ctx context.Context, db sqlla.DB) (sql.Result, error) {
+	query, args, err := q.ToSql()
+	if err != nil {
+
+		return nil, err
+	}
+	result, err := db.ExecContext(ctx, query, args...)
+	return result, err
+}
+
+type documentRequestDefaultInsertHooker interface {
+	DefaultInsertHook(documentRequestInsertSQL) (documentRequestInsertSQL, error)
+}
+
+type documentRequestInsertSQLToSqler interface {
+	documentRequestInsertSQLToSql() (string, []interface{}, error)
+}
+
+type documentRequestInsertOnDuplicateKeyUpdateSQL struct {
+	insertSQL               documentRequestInsertSQLToSqler
+	onDuplicateKeyUpdateMap sqlla.SetMap
+}
+
+func (q documentRequestInsertOnDuplicateKeyUpdateSQL) ValueOnUpdateID(v int64) documentRequestInsertOnDuplicateKeyUpdateSQL {
+	q.onDuplicateKeyUpdateMap["`id`"] = v
+	return q
+}
+
+func (q documentRequestInsertOnDuplicateKeyUpdateSQL) RawValueOnUpdateID(v sqlla.SetMapRawValue) documentRequestInsertOnDuplicateKeyUpdateSQL {
+	q.onDuplicateKeyUpdateMap["`id`"] = v
+	return q
+}
+
+func (q documentRequestInsertOnDuplicateKeyUpdateSQL) SameOnUpdateID() documentRequestInsertOnDuplicateKeyUpdateSQL {
+	q.onDuplicateKeyUpdateMap["`id`"] = sqlla.SetMapRawValue("VALUES(`id`)")
+	return q
+}
+
+func (q documentRequestInsertOnDuplicateKeyUpdateSQL) ValueOnUpdateEstateID(v int64) documentRequestInsertOnDuplicateKeyUpdateSQL {
+	q.onDuplicateKeyUpdateMap["`estate_id`"] = v
+	return q
+}
+
+func (q documentRequestInsertOnDuplicateKeyUpdateSQL) RawValueOnUpdateEstateID(v sqlla.SetMapRawValue) documentRequestInsertOnDuplicateKeyUpdateSQL {
+	q.onDuplicateKeyUpdateMap["`estate_id`"] = v
+	return q
+}
+
+func (q documentRequestInsertOnDuplicateKeyUpdateSQL) SameOnUpdateEstateID() documentRequestInsertOnDuplicateKeyUpdateSQL {
+	q.onDuplicateKeyUpdateMap["`estate_id`"] = sqlla.SetMapRawValue("VALUES(`estate_id`)")
+	return q
+}
+
+func (q documentRequestInsertOnDuplicateKeyUpdateSQL) ValueOnUpdateEmail(v string) documentRequestInsertOnDuplicateKeyUpdateSQL {
+	q.onDuplicateKeyUpdateMap["`email`"] = v
+	return q
+}
+
+func (q documentRequestInsertOnDuplicateKeyUpdateSQL) RawValueOnUpdateEmail(v sqlla.SetMapRawValue) documentRequestInsertOnDuplicateKeyUpdateSQL {
+	q.onDuplicateKeyUpdateMap["`email`"] = v
+	return q
+}
+
+func (q documentRequestInsertOnDuplicateKeyUpdateSQL) SameOnUpdateEmail() documentRequestInsertOnDuplicateKeyUpdateSQL {
+	q.onDuplicateKeyUpdateMap["`email`"] = sqlla.SetMapRawValue("VALUES(`email`)")
+	return q
+}
+
+func (q documentRequestInsertOnDuplicateKeyUpdateSQL) ValueOnUpdateCreatedAt(v time.Time) documentRequestInsertOnDuplicateKeyUpdateSQL {
+	q.onDuplicateKeyUpdateMap["`created_at`"] = v
+	return q
+}
+
+func (q documentRequestInsertOnDuplicateKeyUpdateSQL) RawValueOnUpdateCreatedAt(v sqlla.SetMapRawValue) documentRequestInsertOnDuplicateKeyUpdateSQL {
+	q.onDuplicateKeyUpdateMap["`created_at`"] = v
+	return q
+}
+
+func (q documentRequestInsertOnDuplicateKeyUpdateSQL) SameOnUpdateCreatedAt() documentRequestInsertOnDuplicateKeyUpdateSQL {
+	q.onDuplicateKeyUpdateMap["`created_at`"] = sqlla.SetMapRawValue("VALUES(`created_at`)")
+	return q
+}
+
+func (q documentRequestInsertOnDuplicateKeyUpdateSQL) ToSql() (string, []interface{}, error) {
+	var err error
+	var s interface{} = DocumentRequest{}
+	if t, ok := s.(documentRequestDefaultInsertOnDuplicateKeyUpdateHooker); ok {
+		q, err = t.DefaultInsertOnDuplicateKeyUpdateHook(q)
+		if err != nil {
+			return "", []interface{}{}, err
+		}
+	}
+
+	query, vs, err := q.insertSQL.documentRequestInsertSQLToSql()
+	if err != nil {
+		return "", []interface{}{}, err
+	}
+
+	os, ovs, err := q.onDuplicateKeyUpdateMap.ToUpdateSql()
+	if err != nil {
+		return "", []interface{}{}, err
+	}
+	query += " ON DUPLICATE KEY UPDATE" + os
+	vs = append(vs, ovs...)
+
+	return query + ";", vs, nil
+}
+
+func (q documentRequestInsertOnDuplicateKeyUpdateSQL) ExecContext(ctx context.Context, db sqlla.DB) (sql.Result, error) {
+	query, args, err := q.ToSql()
+	if err != nil {
+
+		return nil, err
+	}
+	result, err := db.ExecContext(ctx, query, args...)
+	return result, err
+}
+
+type documentRequestDefaultInsertOnDuplicateKeyUpdateHooker interface {
+	DefaultInsertOnDuplicateKeyUpdateHook(documentRequestInsertOnDuplicateKeyUpdateSQL) (documentRequestInsertOnDuplicateKeyUpdateSQL, error)
+}
+
+type documentRequestBulkInsertSQL struct {
+	insertSQLs []documentRequestInsertSQL
+}
+
+func (q documentRequestSQL) BulkInsert() *documentRequestBulkInsertSQL {
+	return &documentRequestBulkInsertSQL{
+		insertSQLs: []documentRequestInsertSQL{},
+	}
+}
+
+func (q *documentRequestBulkInsertSQL) Append(iqs ...documentRequestInsertSQL) {
+	q.insertSQLs = append(q.insertSQLs, iqs...)
+}
+
+func (q *documentRequestBulkInsertSQL) documentRequestInsertSQLToSql() (string, []interface{}, error) {
+	if len(q.insertSQLs) == 0 {
+		return "", []interface{}{}, fmt.Errorf("sqlla: This documentRequestBulkInsertSQL's InsertSQL was empty")
+	}
+	iqs := make([]documentRequestInsertSQL, len(q.insertSQLs))
+	copy(iqs, q.insertSQLs)
+
+	var s interface{} = DocumentRequest{}
+	if t, ok := s.(documentRequestDefaultInsertHooker); ok {
+		for i, iq := range iqs {
+			var err error
+			iq, err = t.DefaultInsertHook(iq)
+			if err != nil {
+				return "", []interface{}{}, err
+			}
+			iqs[i] = iq
+		}
+	}
+
+	sms := make(sqlla.SetMaps, 0, len(q.insertSQLs))
+	for _, iq := range q.insertSQLs {
+		sms = append(sms, iq.setMap)
+	}
+
+	query, vs, err := sms.ToInsertSql()
+	if err != nil {
+		return "", []interface{}{}, err
+	}
+
+	return "INSERT INTO `document_requests` " + query, vs, nil
+}
+
+func (q *documentRequestBulkInsertSQL) ToSql() (string, []interface{}, error) {
+	query, vs, err := q.documentRequestInsertSQLToSql()
+	if err != nil {
+		return "", []interface{}{}, err
+	}
+	return query + ";", vs, nil
+}
+
+func (q *documentRequestBulkInsertSQL) OnDuplicateKeyUpdate() documentRequestInsertOnDuplicateKeyUpdateSQL {
+	return documentRequestInsertOnDuplicateKeyUpdateSQL{
+		insertSQL:               q,
+		onDuplicateKeyUpdateMap: sqlla.SetMap{},
+	}
+}
+
+func (q *documentRequestBulkInsertSQL) ExecContext(ctx context.Context, db sqlla.DB) (sql.Result, error) {
+	query, args, err := q.ToSql()
+	if err != nil {
+		return nil, err
+	}
+	result, err := db.ExecContext(ctx, query, args...)
+	return result, err
+}
+
+type documentRequestDeleteSQL struct {
+	documentRequestSQL
+}
+
+func (q documentRequestSQL) Delete() documentRequestDeleteSQL {
+	return documentRequestDeleteSQL{
+		q,
+	}
+}
+
+func (q documentRequestDeleteSQL) ID(v int64, exprs ...sqlla.Operator) documentRequestDeleteSQL {
+	var op sqlla.Operator
+	if len(exprs) == 0 {
+		op = sqlla.OpEqual
+	} else {
+		op = exprs[0]
+	}
+	where := sqlla.ExprInt64{Value: v, Op: op, Column: "`id`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q documentRequestDeleteSQL) IDIn(vs ...int64) documentRequestDeleteSQL {
+	where := sqlla.ExprMultiInt64{Values: vs, Op: sqlla.MakeInOperator(len(vs)), Column: "`id`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q documentRequestDeleteSQL) EstateID(v int64, exprs ...sqlla.Operator) documentRequestDeleteSQL {
+	var op sqlla.Operator
+	if len(exprs) == 0 {
+		op = sqlla.OpEqual
+	} else {
+		op = exprs[0]
+	}
+	where := sqlla.ExprInt64{Value: v, Op: op, Column: "`estate_id`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q documentRequestDeleteSQL) EstateIDIn(vs ...int64) documentRequestDeleteSQL {
+	where := sqlla.ExprMultiInt64{Values: vs, Op: sqlla.MakeInOperator(len(vs)), Column: "`estate_id`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q documentRequestDeleteSQL) Email(v string, exprs ...sqlla.Operator) documentRequestDeleteSQL {
+	var op sqlla.Operator
+	if len(exprs) == 0 {
+		op = sqlla.OpEqual
+	} else {
+		op = exprs[0]
+	}
+	where := sqlla.ExprString{Value: v, Op: op, Column: "`email`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q documentRequestDeleteSQL) EmailIn(vs ...string) documentRequestDeleteSQL {
+	where := sqlla.ExprMultiString{Values: vs, Op: sqlla.MakeInOperator(len(vs)), Column: "`email`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q documentRequestDeleteSQL) CreatedAt(v time.Time, exprs ...sqlla.Operator) documentRequestDeleteSQL {
+	var op sqlla.Operator
+	if len(exprs) == 0 {
+		op = sqlla.OpEqual
+	} else {
+		op = exprs[0]
+	}
+	where := sqlla.ExprTime{Value: v, Op: op, Column: "`created_at`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q documentRequestDeleteSQL) CreatedAtIn(vs ...time.Time) documentRequestDeleteSQL {
+	where := sqlla.ExprMultiTime{Values: vs, Op: sqlla.MakeInOperator(len(vs)), Column: "`created_at`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q documentRequestDeleteSQL) ToSql() (string, []interface{}, error) {
+	wheres, vs, err := q.where.ToSql()
+	if err != nil {
+		return "", nil, err
+	}
+
+	query := "DELETE FROM document_requests"
+	if wheres != "" {
+		query += " WHERE" + wheres
+	}
+
+	return query + ";", vs, nil
+}
+
+func (q documentRequestDeleteSQL) Exec(db sqlla.DB) (sql.Result, error) {
+	query, args, err := q.ToSql()
+	if err != nil {
+		return nil, err
+	}
+	return db.Exec(query, args...)
+}
+
+func (q documentRequestDeleteSQL) ExecContext(ctx context.Context, db sqlla.DB) (sql.Result, error) {
+	query, args, err := q.ToSql()
+	if err != nil {
+		return nil, err
+	}
+	return db.ExecContext(ctx, query, args...)
+}
@@ -0,0 +1,119 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+)
+
+// ErrorCode クライアントがエラーの種類を判別するための機械可読なコード
+type ErrorCode string
+
+const (
+	ErrCodeBadRequest              ErrorCode = "BAD_REQUEST"
+	ErrCodeValidationFailed        ErrorCode = "VALIDATION_FAILED"
+	ErrCodeInvalidID               ErrorCode = "INVALID_ID"
+	ErrCodeInvalidCursor           ErrorCode = "INVALID_CURSOR"
+	ErrCodeInvalidPage             ErrorCode = "INVALID_PAGE"
+	ErrCodeInvalidPerPage          ErrorCode = "INVALID_PER_PAGE"
+	ErrCodeInvalidLimit            ErrorCode = "INVALID_LIMIT"
+	ErrCodeInvalidSort             ErrorCode = "INVALID_SORT"
+	ErrCodeInvalidQuery            ErrorCode = "INVALID_QUERY"
+	ErrCodeInvalidRangeID          ErrorCode = "INVALID_RANGE_ID"
+	ErrCodeInvalidRangeBound       ErrorCode = "INVALID_RANGE_BOUND"
+	ErrCodeInvalidListValue        ErrorCode = "INVALID_LIST_VALUE"
+	ErrCodeSearchConditionNotFound ErrorCode = "SEARCH_CONDITION_NOT_FOUND"
+	ErrCodeInvalidSearchCondition  ErrorCode = "INVALID_SEARCH_CONDITION"
+	ErrCodeUnauthorized            ErrorCode = "UNAUTHORIZED"
+	ErrCodeInvalidUpload           ErrorCode = "INVALID_UPLOAD"
+	ErrCodeUnsupportedMediaType    ErrorCode = "UNSUPPORTED_MEDIA_TYPE"
+	ErrCodeChairNotFound           ErrorCode = "CHAIR_NOT_FOUND"
+	ErrCodeChairSoldOut            ErrorCode = "CHAIR_SOLD_OUT"
+	ErrCodeInsufficientStock       ErrorCode = "INSUFFICIENT_STOCK"
+	ErrCodeEstateNotFound          ErrorCode = "ESTATE_NOT_FOUND"
+	ErrCodeVersionConflict         ErrorCode = "VERSION_CONFLICT"
+	ErrCodeImportJobNotFound       ErrorCode = "IMPORT_JOB_NOT_FOUND"
+	ErrCodeImportJobFileNotFound   ErrorCode = "IMPORT_JOB_FILE_NOT_FOUND"
+	ErrCodeSoldOutUpdateFailed     ErrorCode = "SOLD_OUT_UPDATE_FAILED"
+	ErrCodeInternal                ErrorCode = "INTERNAL_ERROR"
+)
+
+// APIError ハンドラから返すエラー。httpErrorHandlerがJSONにして返す
+type APIError struct {
+	Status   int          `json:"-"`
+	Code     ErrorCode    `json:"code"`
+	Message  string       `json:"message"`
+	Errors   []FieldError `json:"errors,omitempty"`
+	Internal error        `json:"-"`
+}
+
+func newAPIError(status int, code ErrorCode, message string) *APIError {
+	return &APIError{Status: status, Code: code, Message: message}
+}
+
+// errInternal 500を返すエラー。原因はレスポンスには含めずログにだけ出す
+func errInternal(err error) *APIError {
+	return &APIError{
+		Status:   http.StatusInternalServerError,
+		Code:     ErrCodeInternal,
+		Message:  "internal server error",
+		Internal: err,
+	}
+}
+
+func (e *APIError) Error() string {
+	if e.Internal != nil {
+		return fmt.Sprintf("code=%s, message=%s, internal=%v", e.Code, e.Message, e.Internal)
+	}
+	return fmt.Sprintf("code=%s, message=%s", e.Code, e.Message)
+}
+
+func (e *APIError) Unwrap() error {
+	return e.Internal
+}
+
+// httpErrorHandler ハンドラやechoが返したエラーをAPIErrorの形式のJSONで返す
+func httpErrorHandler(err error, c echo.Context) {
+	if c.Response().Committed {
+		return
+	}
+
+	var ae *APIError
+	if !errors.As(err, &ae) {
+		var he *echo.HTTPError
+		if errors.As(err, &he) {
+			ae = &APIError{
+				Status:   he.Code,
+				Code:     errorCodeFromStatus(he.Code),
+				Message:  fmt.Sprint(he.Message),
+				Internal: he.Internal,
+			}
+		} else {
+			ae = errInternal(err)
+		}
+	}
+	if ae.Status >= http.StatusInternalServerError {
+		c.Logger().Errorf("%s %s : %v", c.Request().Method, c.Request().URL.Path, ae)
+	}
+
+	if c.Request().Method == http.MethodHead {
+		err = c.NoContent(ae.Status)
+	} else {
+		err = c.JSON(ae.Status, ae)
+	}
+	if err != nil {
+		c.Logger().Errorf("failed to send error response : %v", err)
+	}
+}
+
+// errorCodeFromStatus "Method Not Allowed" -> "METHOD_NOT_ALLOWED" のようにステータスからコードを作る
+func errorCodeFromStatus(status int) ErrorCode {
+	text := http.StatusText(status)
+	if text == "" {
+		return ErrCodeInternal
+	}
+	return ErrorCode(strings.ToUpper(strings.NewReplacer(" ", "_", "-", "_", "'", "").Replace(text)))
+}
@@ -22,7 +22,7 @@ func NewEstateSQL() estateSQL {
 }
 
 var estateAllColumns = []string{
-	"`id`", "`thumbnail`", "`name`", "`description`", "`latitude`", "`longitude`", "`address`", "`rent`", "`door_height`", "`door_width`", "`features`", "`popularity`",
+	"`id`", "`thumbnail`", "`name`", "`description`", "`latitude`", "`longitude`", "`address`", "`rent`", "`door_height`", "`door_width`", "`features`", "`popularity`", "`version`", "`status`", "`features_array`", "`rent_range`", "`door_height_range`", "`door_width_range`",
 }
 
 type estateSelectSQL struct {
@@ -477,6 +477,180 @@ func (q estateSelectSQL) OrderByPopularity(order sqlla.Order) estateSelectSQL {
 	return q
 }
 
+func (q estateSelectSQL) Version(v int64, exprs ...sqlla.Operator) estateSelectSQL {
+	var op sqlla.Operator
+	if len(exprs) == 0 {
+		op = sqlla.OpEqual
+	} else {
+		op = exprs[0]
+	}
+	where := sqlla.ExprInt64{Value: v, Op: op, Column: q.appendColumnPrefix("`version`")}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q estateSelectSQL) VersionIn(vs ...int64) estateSelectSQL {
+	where := sqlla.ExprMultiInt64{Values: vs, Op: sqlla.MakeInOperator(len(vs)), Column: q.appendColumnPrefix("`version`")}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q estateSelectSQL) OrderByVersion(order sqlla.Order) estateSelectSQL {
+	q.order = " ORDER BY " + q.appendColumnPrefix("`version`")
+	if order == sqlla.Asc {
+		q.order += " ASC"
+	} else {
+		q.order += " DESC"
+	}
+
+	return q
+}
+
+func (q estateSelectSQL) Status(v string, exprs ...sqlla.Operator) estateSelectSQL {
+	var op sqlla.Operator
+	if len(exprs) == 0 {
+		op = sqlla.OpEqual
+	} else {
+		op = exprs[0]
+	}
+	where := sqlla.ExprString{Value: v, Op: op, Column: q.appendColumnPrefix("`status`")}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q estateSelectSQL) StatusIn(vs ...string) estateSelectSQL {
+	where := sqlla.ExprMultiString{Values: vs, Op: sqlla.MakeInOperator(len(vs)), Column: q.appendColumnPrefix("`status`")}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q estateSelectSQL) OrderByStatus(order sqlla.Order) estateSelectSQL {
+	q.order = " ORDER BY " + q.appendColumnPrefix("`status`")
+	if order == sqlla.Asc {
+		q.order += " ASC"
+	} else {
+		q.order += " DESC"
+	}
+
+	return q
+}
+
+func (q estateSelectSQL) FeaturesArray(v string, exprs ...sqlla.Operator) estateSelectSQL {
+	var op sqlla.Operator
+	if len(exprs) == 0 {
+		op = sqlla.OpEqual
+	} else {
+		op = exprs[0]
+	}
+	where := sqlla.ExprString{Value: v, Op: op, Column: q.appendColumnPrefix("`features_array`")}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q estateSelectSQL) FeaturesArrayIn(vs ...string) estateSelectSQL {
+	where := sqlla.ExprMultiString{Values: vs, Op: sqlla.MakeInOperator(len(vs)), Column: q.appendColumnPrefix("`features_array`")}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q estateSelectSQL) OrderByFeaturesArray(order sqlla.Order) estateSelectSQL {
+	q.order = " ORDER BY " + q.appendColumnPrefix("`features_array`")
+	if order == sqlla.Asc {
+		q.order += " ASC"
+	} else {
+		q.order += " DESC"
+	}
+
+	return q
+}
+
+func (q estateSelectSQL) RentRange(v int64, exprs ...sqlla.Operator) estateSelectSQL {
+	var op sqlla.Operator
+	if len(exprs) == 0 {
+		op = sqlla.OpEqual
+	} else {
+		op = exprs[0]
+	}
+	where := sqlla.ExprInt64{Value: v, Op: op, Column: q.appendColumnPrefix("`rent_range`")}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q estateSelectSQL) RentRangeIn(vs ...int64) estateSelectSQL {
+	where := sqlla.ExprMultiInt64{Values: vs, Op: sqlla.MakeInOperator(len(vs)), Column: q.appendColumnPrefix("`rent_range`")}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q estateSelectSQL) OrderByRentRange(order sqlla.Order) estateSelectSQL {
+	q.order = " ORDER BY " + q.appendColumnPrefix("`rent_range`")
+	if order == sqlla.Asc {
+		q.order += " ASC"
+	} else {
+		q.order += " DESC"
+	}
+
+	return q
+}
+
+func (q estateSelectSQL) DoorHeightRange(v int64, exprs ...sqlla.Operator) estateSelectSQL {
+	var op sqlla.Operator
+	if len(exprs) == 0 {
+		op = sqlla.OpEqual
+	} else {
+		op = exprs[0]
+	}
+	where := sqlla.ExprInt64{Value: v, Op: op, Column: q.appendColumnPrefix("`door_height_range`")}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q estateSelectSQL) DoorHeightRangeIn(vs ...int64) estateSelectSQL {
+	where := sqlla.ExprMultiInt64{Values: vs, Op: sqlla.MakeInOperator(len(vs)), Column: q.appendColumnPrefix("`door_height_range`")}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q estateSelectSQL) OrderByDoorHeightRange(order sqlla.Order) estateSelectSQL {
+	q.order = " ORDER BY " + q.appendColumnPrefix("`door_height_range`")
+	if order == sqlla.Asc {
+		q.order += " ASC"
+	} else {
+		q.order += " DESC"
+	}
+
+	return q
+}
+
+func (q estateSelectSQL) DoorWidthRange(v int64, exprs ...sqlla.Operator) estateSelectSQL {
+	var op sqlla.Operator
+	if len(exprs) == 0 {
+		op = sqlla.OpEqual
+	} else {
+		op = exprs[0]
+	}
+	where := sqlla.ExprInt64{Value: v, Op: op, Column: q.appendColumnPrefix("`door_width_range`")}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q estateSelectSQL) DoorWidthRangeIn(vs ...int64) estateSelectSQL {
+	where := sqlla.ExprMultiInt64{Values: vs, Op: sqlla.MakeInOperator(len(vs)), Column: q.appendColumnPrefix("`door_width_range`")}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q estateSelectSQL) OrderByDoorWidthRange(order sqlla.Order) estateSelectSQL {
+	q.order = " ORDER BY " + q.appendColumnPrefix("`door_width_range`")
+	if order == sqlla.Asc {
+		q.order += " ASC"
+	} else {
+		q.order += " DESC"
+	}
+
+	return q
+}
+
 func (q estateSelectSQL) ToSql() (string, []interface{}, error) {
 	columns := strings.Join(q.Columns, ", ")
 	wheres, vs, err := q.where.ToSql()
@@ -613,6 +787,12 @@ func (q estateSelectSQL) Scan(s sqlla.Scanner) (Estate, error) {
 		&row.DoorWidth,
 		&row.Features,
 		&row.Popularity,
+		&row.Version,
+		&row.Status,
+		&row.FeaturesArray,
+		&row.RentRange,
+		&row.DoorHeightRange,
+		&row.DoorWidthRange,
 	)
 	return row, err
 }
@@ -906,6 +1086,144 @@ func (q estateUpdateSQL) WherePopularityIn(vs ...int64) estateUpdateSQL {
 	return q
 }
 
+func (q estateUpdateSQL) SetVersion(v int64) estateUpdateSQL {
+	q.setMap["`version`"] = v
+	return q
+}
+
+func (q estateUpdateSQL) WhereVersion(v int64, exprs ...sqlla.Operator) estateUpdateSQL {
+	var op sqlla.Operator
+	if len(exprs) == 0 {
+		op = sqlla.OpEqual
+	} else {
+		op = exprs[0]
+	}
+	where := sqlla.ExprInt64{Value: v, Op: op, Column: "`version`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q estateUpdateSQL) WhereVersionIn(vs ...int64) estateUpdateSQL {
+	where := sqlla.ExprMultiInt64{Values: vs, Op: sqlla.MakeInOperator(len(vs)), Column: "`version`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q estateUpdateSQL) SetStatus(v string) estateUpdateSQL {
+	q.setMap["`status`"] = v
+	return q
+}
+
+func (q estateUpdateSQL) WhereStatus(v string, exprs ...sqlla.Operator) estateUpdateSQL {
+	var op sqlla.Operator
+	if len(exprs) == 0 {
+		op = sqlla.OpEqual
+	} else {
+		op = exprs[0]
+	}
+	where := sqlla.ExprString{Value: v, Op: op, Column: "`status`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q estateUpdateSQL) WhereStatusIn(vs ...string) estateUpdateSQL {
+	where := sqlla.ExprMultiString{Values: vs, Op: sqlla.MakeInOperator(len(vs)), Column: "`status`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q estateUpdateSQL) SetFeaturesArray(v string) estateUpdateSQL {
+	q.setMap["`features_array`"] = v
+	return q
+}
+
+func (q estateUpdateSQL) WhereFeaturesArray(v string, exprs ...sqlla.Operator) estateUpdateSQL {
+	var op sqlla.Operator
+	if len(exprs) == 0 {
+		op = sqlla.OpEqual
+	} else {
+		op = exprs[0]
+	}
+	where := sqlla.ExprString{Value: v, Op: op, Column: "`features_array`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q estateUpdateSQL) WhereFeaturesArrayIn(vs ...string) estateUpdateSQL {
+	where := sqlla.ExprMultiString{Values: vs, Op: sqlla.MakeInOperator(len(vs)), Column: "`features_array`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q estateUpdateSQL) SetRentRange(v int64) estateUpdateSQL {
+	q.setMap["`rent_range`"] = v
+	return q
+}
+
+func (q estateUpdateSQL) WhereRentRange(v int64, exprs ...sqlla.Operator) estateUpdateSQL {
+	var op sqlla.Operator
+	if len(exprs) == 0 {
+		op = sqlla.OpEqual
+	} else {
+		op = exprs[0]
+	}
+	where := sqlla.ExprInt64{Value: v, Op: op, Column: "`rent_range`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q estateUpdateSQL) WhereRentRangeIn(vs ...int64) estateUpdateSQL {
+	where := sqlla.ExprMultiInt64{Values: vs, Op: sqlla.MakeInOperator(len(vs)), Column: "`rent_range`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q estateUpdateSQL) SetDoorHeightRange(v int64) estateUpdateSQL {
+	q.setMap["`door_height_range`"] = v
+	return q
+}
+
+func (q estateUpdateSQL) WhereDoorHeightRange(v int64, exprs ...sqlla.Operator) estateUpdateSQL {
+	var op sqlla.Operator
+	if len(exprs) == 0 {
+		op = sqlla.OpEqual
+	} else {
+		op = exprs[0]
+	}
+	where := sqlla.ExprInt64{Value: v, Op: op, Column: "`door_height_range`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q estateUpdateSQL) WhereDoorHeightRangeIn(vs ...int64) estateUpdateSQL {
+	where := sqlla.ExprMultiInt64{Values: vs, Op: sqlla.MakeInOperator(len(vs)), Column: "`door_height_range`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q estateUpdateSQL) SetDoorWidthRange(v int64) estateUpdateSQL {
+	q.setMap["`door_width_range`"] = v
+	return q
+}
+
+func (q estateUpdateSQL) WhereDoorWidthRange(v int64, exprs ...sqlla.Operator) estateUpdateSQL {
+	var op sqlla.Operator
+	if len(exprs) == 0 {
+		op = sqlla.OpEqual
+	} else {
+		op = exprs[0]
+	}
+	where := sqlla.ExprInt64{Value: v, Op: op, Column: "`door_width_range`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q estateUpdateSQL) WhereDoorWidthRangeIn(vs ...int64) estateUpdateSQL {
+	where := sqlla.ExprMultiInt64{Values: vs, Op: sqlla.MakeInOperator(len(vs)), Column: "`door_width_range`"}
+	q.where = append(q.where, where)
+	return q
+}
+
 func (q estateUpdateSQL) ToSql() (string, []interface{}, error) {
 	var err error
 	var s interface{} = Estate{}
@@ -1024,6 +1342,36 @@ func (q estateInsertSQL) ValuePopularity(v int64) estateInsertSQL {
 	return q
 }
 
+func (q estateInsertSQL) ValueVersion(v int64) estateInsertSQL {
+	q.setMap["`version`"] = v
+	return q
+}
+
+func (q estateInsertSQL) ValueStatus(v string) estateInsertSQL {
+	q.setMap["`status`"] = v
+	return q
+}
+
+func (q estateInsertSQL) ValueFeaturesArray(v string) estateInsertSQL {
+	q.setMap["`features_array`"] = v
+	return q
+}
+
+func (q estateInsertSQL) ValueRentRange(v int64) estateInsertSQL {
+	q.setMap["`rent_range`"] = v
+	return q
+}
+
+func (q estateInsertSQL) ValueDoorHeightRange(v int64) estateInsertSQL {
+	q.setMap["`door_height_range`"] = v
+	return q
+}
+
+func (q estateInsertSQL) ValueDoorWidthRange(v int64) estateInsertSQL {
+	q.setMap["`door_width_range`"] = v
+	return q
+}
+
 func (q estateInsertSQL) ToSql() (string, []interface{}, error) {
 	query, vs, err := q.estateInsertSQLToSql()
 	if err != nil {
@@ -1271,6 +1619,96 @@ func (q estateInsertOnDuplicateKeyUpdateSQL) SameOnUpdatePopularity() estateInse
 	return q
 }
 
+func (q estateInsertOnDuplicateKeyUpdateSQL) ValueOnUpdateVersion(v int64) estateInsertOnDuplicateKeyUpdateSQL {
+	q.onDuplicateKeyUpdateMap["`version`"] = v
+	return q
+}
+
+func (q estateInsertOnDuplicateKeyUpdateSQL) RawValueOnUpdateVersion(v sqlla.SetMapRawValue) estateInsertOnDuplicateKeyUpdateSQL {
+	q.onDuplicateKeyUpdateMap["`version`"] = v
+	return q
+}
+
+func (q estateInsertOnDuplicateKeyUpdateSQL) SameOnUpdateVersion() estateInsertOnDuplicateKeyUpdateSQL {
+	q.onDuplicateKeyUpdateMap["`version`"] = sqlla.SetMapRawValue("VALUES(`version`)")
+	return q
+}
+
+func (q estateInsertOnDuplicateKeyUpdateSQL) ValueOnUpdateStatus(v string) estateInsertOnDuplicateKeyUpdateSQL {
+	q.onDuplicateKeyUpdateMap["`status`"] = v
+	return q
+}
+
+func (q estateInsertOnDuplicateKeyUpdateSQL) RawValueOnUpdateStatus(v sqlla.SetMapRawValue) estateInsertOnDuplicateKeyUpdateSQL {
+	q.onDuplicateKeyUpdateMap["`status`"] = v
+	return q
+}
+
+func (q estateInsertOnDuplicateKeyUpdateSQL) SameOnUpdateStatus() estateInsertOnDuplicateKeyUpdateSQL {
+	q.onDuplicateKeyUpdateMap["`status`"] = sqlla.SetMapRawValue("VALUES(`status`)")
+	return q
+}
+
+func (q estateInsertOnDuplicateKeyUpdateSQL) ValueOnUpdateFeaturesArray(v string) estateInsertOnDuplicateKeyUpdateSQL {
+	q.onDuplicateKeyUpdateMap["`features_array`"] = v
+	return q
+}
+
+func (q estateInsertOnDuplicateKeyUpdateSQL) RawValueOnUpdateFeaturesArray(v sqlla.SetMapRawValue) estateInsertOnDuplicateKeyUpdateSQL {
+	q.onDuplicateKeyUpdateMap["`features_array`"] = v
+	return q
+}
+
+func (q estateInsertOnDuplicateKeyUpdateSQL) SameOnUpdateFeaturesArray() estateInsertOnDuplicateKeyUpdateSQL {
+	q.onDuplicateKeyUpdateMap["`features_array`"] = sqlla.SetMapRawValue("VALUES(`features_array`)")
+	return q
+}
+
+func (q estateInsertOnDuplicateKeyUpdateSQL) ValueOnUpdateRentRange(v int64) estateInsertOnDuplicateKeyUpdateSQL {
+	q.onDuplicateKeyUpdateMap["`rent_range`"] = v
+	return q
+}
+
+func (q estateInsertOnDuplicateKeyUpdateSQL) RawValueOnUpdateRentRange(v sqlla.SetMapRawValue) estateInsertOnDuplicateKeyUpdateSQL {
+	q.onDuplicateKeyUpdateMap["`rent_range`"] = v
+	return q
+}
+
+func (q estateInsertOnDuplicateKeyUpdateSQL) SameOnUpdateRentRange() estateInsertOnDuplicateKeyUpdateSQL {
+	q.onDuplicateKeyUpdateMap["`rent_range`"] = sqlla.SetMapRawValue("VALUES(`rent_range`)")
+	return q
+}
+
+func (q estateInsertOnDuplicateKeyUpdateSQL) ValueOnUpdateDoorHeightRange(v int64) estateInsertOnDuplicateKeyUpdateSQL {
+	q.onDuplicateKeyUpdateMap["`door_height_range`"] = v
+	return q
+}
+
+func (q estateInsertOnDuplicateKeyUpdateSQL) RawValueOnUpdateDoorHeightRange(v sqlla.SetMapRawValue) estateInsertOnDuplicateKeyUpdateSQL {
+	q.onDuplicateKeyUpdateMap["`door_height_range`"] = v
+	return q
+}
+
+func (q estateInsertOnDuplicateKeyUpdateSQL) SameOnUpdateDoorHeightRange() estateInsertOnDuplicateKeyUpdateSQL {
+	q.onDuplicateKeyUpdateMap["`door_height_range`"] = sqlla.SetMapRawValue("VALUES(`door_height_range`)")
+	return q
+}
+
+func (q estateInsertOnDuplicateKeyUpdateSQL) ValueOnUpdateDoorWidthRange(v int64) estateInsertOnDuplicateKeyUpdateSQL {
+	q.onDuplicateKeyUpdateMap["`door_width_range`"] = v
+	return q
+}
+
+func (q estateInsertOnDuplicateKeyUpdateSQL) RawValueOnUpdateDoorWidthRange(v sqlla.SetMapRawValue) estateInsertOnDuplicateKeyUpdateSQL {
+	q.onDuplicateKeyUpdateMap["`door_width_range`"] = v
+	return q
+}
+
+func (q estateInsertOnDuplicateKeyUpdateSQL) SameOnUpdateDoorWidthRange() estateInsertOnDuplicateKeyUpdateSQL {
+	q.onDuplicateKeyUpdateMap["`door_width_range`"] = sqlla.SetMapRawValue("VALUES(`door_width_range`)")
+	return q
+}
+
 func (q estateInsertOnDuplicateKeyUpdateSQL) ToSql() (string, []interface{}, error) {
 	var err error
 	var s interface{} = Estate{}
@@ -1606,6 +2044,114 @@ func (q estateDeleteSQL) PopularityIn(vs ...int64) estateDeleteSQL {
 	return q
 }
 
+func (q estateDeleteSQL) Version(v int64, exprs ...sqlla.Operator) estateDeleteSQL {
+	var op sqlla.Operator
+	if len(exprs) == 0 {
+		op = sqlla.OpEqual
+	} else {
+		op = exprs[0]
+	}
+	where := sqlla.ExprInt64{Value: v, Op: op, Column: "`version`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q estateDeleteSQL) VersionIn(vs ...int64) estateDeleteSQL {
+	where := sqlla.ExprMultiInt64{Values: vs, Op: sqlla.MakeInOperator(len(vs)), Column: "`version`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q estateDeleteSQL) Status(v string, exprs ...sqlla.Operator) estateDeleteSQL {
+	var op sqlla.Operator
+	if len(exprs) == 0 {
+		op = sqlla.OpEqual
+	} else {
+		op = exprs[0]
+	}
+	where := sqlla.ExprString{Value: v, Op: op, Column: "`status`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q estateDeleteSQL) StatusIn(vs ...string) estateDeleteSQL {
+	where := sqlla.ExprMultiString{Values: vs, Op: sqlla.MakeInOperator(len(vs)), Column: "`status`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q estateDeleteSQL) FeaturesArray(v string, exprs ...sqlla.Operator) estateDeleteSQL {
+	var op sqlla.Operator
+	if len(exprs) == 0 {
+		op = sqlla.OpEqual
+	} else {
+		op = exprs[0]
+	}
+	where := sqlla.ExprString{Value: v, Op: op, Column: "`features_array`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q estateDeleteSQL) FeaturesArrayIn(vs ...string) estateDeleteSQL {
+	where := sqlla.ExprMultiString{Values: vs, Op: sqlla.MakeInOperator(len(vs)), Column: "`features_array`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q estateDeleteSQL) RentRange(v int64, exprs ...sqlla.Operator) estateDeleteSQL {
+	var op sqlla.Operator
+	if len(exprs) == 0 {
+		op = sqlla.OpEqual
+	} else {
+		op = exprs[0]
+	}
+	where := sqlla.ExprInt64{Value: v, Op: op, Column: "`rent_range`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q estateDeleteSQL) RentRangeIn(vs ...int64) estateDeleteSQL {
+	where := sqlla.ExprMultiInt64{Values: vs, Op: sqlla.MakeInOperator(len(vs)), Column: "`rent_range`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q estateDeleteSQL) DoorHeightRange(v int64, exprs ...sqlla.Operator) estateDeleteSQL {
+	var op sqlla.Operator
+	if len(exprs) == 0 {
+		op = sqlla.OpEqual
+	} else {
+		op = exprs[0]
+	}
+	where := sqlla.ExprInt64{Value: v, Op: op, Column: "`door_height_range`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q estateDeleteSQL) DoorHeightRangeIn(vs ...int64) estateDeleteSQL {
+	where := sqlla.ExprMultiInt64{Values: vs, Op: sqlla.MakeInOperator(len(vs)), Column: "`door_height_range`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q estateDeleteSQL) DoorWidthRange(v int64, exprs ...sqlla.Operator) estateDeleteSQL {
+	var op sqlla.Operator
+	if len(exprs) == 0 {
+		op = sqlla.OpEqual
+	} else {
+		op = exprs[0]
+	}
+	where := sqlla.ExprInt64{Value: v, Op: op, Column: "`door_width_range`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q estateDeleteSQL) DoorWidthRangeIn(vs ...int64) estateDeleteSQL {
+	where := sqlla.ExprMultiInt64{Values: vs, Op: sqlla.MakeInOperator(len(vs)), Column: "`door_width_range`"}
+	q.where = append(q.where, where)
+	return q
+}
+
 func (q estateDeleteSQL) ToSql() (string, []interface{}, error) {
 	wheres, vs, err := q.where.ToSql()
 	if err != nil {
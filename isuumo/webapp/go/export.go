@@ -0,0 +1,220 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/labstack/echo/v4"
+)
+
+// exportFetchSize 書き出しでカーソルから1回に読む行数
+const exportFetchSize = 1000
+
+var exportContentTypes = map[uploadFormat]string{
+	uploadFormatCSV:    "text/csv; charset=UTF-8",
+	uploadFormatNDJSON: "application/x-ndjson",
+}
+
+// exportFormatParam 書き出しの形式をformatクエリパラメータ、なければAcceptヘッダーで決める。どちらもなければCSV
+func exportFormatParam(c echo.Context) (uploadFormat, error) {
+	switch f := uploadFormat(c.QueryParam("format")); f {
+	case uploadFormatCSV, uploadFormatNDJSON:
+		return f, nil
+	case "":
+	default:
+		c.Logger().Infof("Invalid format parameter : %v", f)
+		return "", newAPIError(http.StatusBadRequest, ErrCodeBadRequest, fmt.Sprintf("format must be one of csv or ndjson : %q", f))
+	}
+
+	for _, accept := range strings.Split(c.Request().Header.Get(echo.HeaderAccept), ",") {
+		mediaType, _, err := mime.ParseMediaType(strings.TrimSpace(accept))
+		if err != nil {
+			continue
+		}
+		switch mediaType {
+		case "text/csv":
+			return uploadFormatCSV, nil
+		case "application/x-ndjson":
+			return uploadFormatNDJSON, nil
+		}
+	}
+	return uploadFormatCSV, nil
+}
+
+// exportStatusParam statusクエリパラメータを読む。省略したらactive。
+// 書き出した行には公開状態の列がなく、入稿し直すとactiveになるので、状態ごとに分けて書き出す
+func exportStatusParam(c echo.Context) (string, error) {
+	switch s := c.QueryParam("status"); s {
+	case "":
+		return StatusActive, nil
+	case StatusActive, StatusHidden, StatusArchived:
+		return s, nil
+	default:
+		c.Logger().Infof("Invalid status parameter : %v", s)
+		return "", newAPIError(http.StatusBadRequest, ErrCodeBadRequest, fmt.Sprintf("status must be one of active, hidden or archived : %q", s))
+	}
+}
+
+// exportChairs 検索と同じ条件で絞った椅子を、postChairにそのまま入稿できる形で書き出す。売り切れの椅子も含む
+func exportChairs(c echo.Context) error {
+	conditions, params, err := chairSearchWhere(c, currentSearchConditions().Chair, "")
+	if err != nil {
+		return err
+	}
+	return exportRows(c, chairDB, "chair", conditions, params, chairUploadKeys, func(ch Chair) []interface{} {
+		return chairUpload{Chair: ch, Popularity: ch.Popularity, Stock: ch.Stock}.row().Values
+	})
+}
+
+// exportEstates 検索と同じ条件で絞った物件を、postEstateにそのまま入稿できる形で書き出す
+func exportEstates(c echo.Context) error {
+	conditions, params, err := estateSearchWhere(c, currentSearchConditions().Estate, "")
+	if err != nil {
+		return err
+	}
+	return exportRows(c, estateDB, "estate", conditions, params, estateUploadKeys, func(e Estate) []interface{} {
+		return estateUpload{Estate: e, Popularity: e.Popularity}.row().Values
+	})
+}
+
+// exportRows tableの行をid順にサーバー側のカーソルで少しずつ読み、valuesの先頭のkeysの数だけの値を1行ずつ書く。
+// CSVは入稿の列の順番、NDJSONはkeysをキーにする
+func exportRows[T any](c echo.Context, db *sqlx.DB, table string, conditions []string, params []interface{}, keys []string, values func(T) []interface{}) error {
+	format, err := exportFormatParam(c)
+	if err != nil {
+		return err
+	}
+	status, err := exportStatusParam(c)
+	if err != nil {
+		return err
+	}
+	conditions = append(conditions, "status = ?")
+	params = append(params, status)
+
+	ctx := c.Request().Context()
+	tx, err := db.BeginTxx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return errInternal(fmt.Errorf("failed to begin export transaction : %w", err))
+	}
+	defer tx.Rollback()
+
+	query := fmt.Sprintf("DECLARE export_cursor NO SCROLL CURSOR FOR SELECT * FROM %s WHERE %s ORDER BY id", table, strings.Join(conditions, " AND "))
+	if _, err := tx.ExecContext(ctx, query, params...); err != nil {
+		return errInternal(fmt.Errorf("failed to declare %s export cursor : %w", table, err))
+	}
+	rows, err := fetchExportRows[T](ctx, tx)
+	if err != nil {
+		return errInternal(err)
+	}
+
+	res := c.Response()
+	res.Header().Set(echo.HeaderContentType, exportContentTypes[format])
+	res.Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%q", table+"."+string(format)))
+	res.WriteHeader(http.StatusOK)
+
+	var write func([]interface{}) error
+	var flush func() error
+	if format == uploadFormatCSV {
+		w := csv.NewWriter(res)
+		write = func(vs []interface{}) error {
+			record := make([]string, len(vs))
+			for i, v := range vs {
+				record[i] = exportCSVValue(v)
+			}
+			return w.Write(record)
+		}
+		flush = func() error {
+			w.Flush()
+			return w.Error()
+		}
+	} else {
+		w := bufio.NewWriter(res)
+		write = func(vs []interface{}) error {
+			b, err := ndjsonObject(keys, vs)
+			if err != nil {
+				return err
+			}
+			_, err = w.Write(b)
+			return err
+		}
+		flush = w.Flush
+	}
+
+	for len(rows) > 0 {
+		for _, row := range rows {
+			if err := write(values(row)[:len(keys)]); err != nil {
+				return abortExport(c, table, err)
+			}
+		}
+		if err := flush(); err != nil {
+			return abortExport(c, table, err)
+		}
+		res.Flush()
+
+		if rows, err = fetchExportRows[T](ctx, tx); err != nil {
+			return abortExport(c, table, err)
+		}
+	}
+	return nil
+}
+
+func fetchExportRows[T any](ctx context.Context, tx *sqlx.Tx) ([]T, error) {
+	var rows []T
+	if err := tx.SelectContext(ctx, &rows, fmt.Sprintf("FETCH FORWARD %d FROM export_cursor", exportFetchSize)); err != nil {
+		return nil, fmt.Errorf("failed to fetch export cursor : %w", err)
+	}
+	return rows, nil
+}
+
+// abortExport 書き出しの途中で失敗したら接続を切る。
+// 200を返したあとなので、そのまま終えると途中までのファイルが正常に書き出せたように見えてしまう
+func abortExport(c echo.Context, table string, err error) error {
+	c.Logger().Errorf("failed to export %s : %v", table, err)
+	panic(http.ErrAbortHandler)
+}
+
+// exportCSVValue RecordMapperで同じ値に読み戻せる文字列にする
+func exportCSVValue(v interface{}) string {
+	switch v := v.(type) {
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// ndjsonObject keysとvaluesを順番どおりに並べた1行のJSONオブジェクトにする
+func ndjsonObject(keys []string, values []interface{}) ([]byte, error) {
+	var b bytes.Buffer
+	b.WriteByte('{')
+	for i, k := range keys {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		kb, err := json.Marshal(k)
+		if err != nil {
+			return nil, err
+		}
+		vb, err := json.Marshal(values[i])
+		if err != nil {
+			return nil, err
+		}
+		b.Write(kb)
+		b.WriteByte(':')
+		b.Write(vb)
+	}
+	b.WriteString("}\n")
+	return b.Bytes(), nil
+}
@@ -0,0 +1,108 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// FacetCount ファセットの値ごとの件数。valueは検索のクエリパラメータにそのまま渡せる値
+type FacetCount struct {
+	Value string `json:"value"`
+	Label string `json:"label"`
+	Count int64  `json:"count"`
+}
+
+// facet 件数を数える単位。nameはSearchWhereのexceptに渡す名前で、レスポンスのキーにもなる
+type facet struct {
+	name   string
+	column string
+	values []FacetCount
+}
+
+func chairFacets(sc ChairSearchCondition) []facet {
+	return []facet{
+		rangeFacet("price", "price_range", sc.Price),
+		rangeFacet("height", "height_range", sc.Height),
+		rangeFacet("width", "width_range", sc.Width),
+		rangeFacet("depth", "depth_range", sc.Depth),
+		listFacet("kind", "kind", sc.Kind),
+		listFacet("color", "color", sc.Color),
+		listFacet("features", "", sc.Feature),
+	}
+}
+
+func estateFacets(sc EstateSearchCondition) []facet {
+	return []facet{
+		rangeFacet("rent", "rent_range", sc.Rent),
+		rangeFacet("doorHeight", "door_height_range", sc.DoorHeight),
+		rangeFacet("doorWidth", "door_width_range", sc.DoorWidth),
+		listFacet("features", "", sc.Feature),
+	}
+}
+
+// rangeFacet ラベルはフロントエンドのRangeFormと同じ形にする
+func rangeFacet(name, column string, cond RangeCondition) facet {
+	values := make([]FacetCount, 0, len(cond.Ranges))
+	for _, r := range cond.Ranges {
+		var minLabel, maxLabel string
+		if r.Min != -1 {
+			minLabel = fmt.Sprintf("%s%d%s ", cond.Prefix, r.Min, cond.Suffix)
+		}
+		if r.Max != -1 {
+			maxLabel = fmt.Sprintf(" %s%d%s", cond.Prefix, r.Max, cond.Suffix)
+		}
+		values = append(values, FacetCount{
+			Value: strconv.FormatInt(r.ID, 10),
+			Label: minLabel + "〜" + maxLabel,
+		})
+	}
+	return facet{name: name, column: column, values: values}
+}
+
+// listFacet columnが空ならfeatures_arrayを展開して特徴ごとに数える
+func listFacet(name, column string, cond ListCondition) facet {
+	values := make([]FacetCount, 0, len(cond.List))
+	for _, v := range cond.List {
+		values = append(values, FacetCount{Value: v, Label: v})
+	}
+	return facet{name: name, column: column, values: values}
+}
+
+// count conditionsで絞った行をファセットの値ごとに数える。検索条件にない値は0件として返す
+func (f facet) count(ctx context.Context, db *sqlx.DB, table string, conditions []string, params []interface{}) ([]FacetCount, error) {
+	column, from := f.column, table
+	if column == "" {
+		column, from = "feature", table+" CROSS JOIN unnest(features_array) AS f(feature)"
+	}
+	query := fmt.Sprintf("SELECT %s::text AS value, COUNT(*) AS count FROM %s", column, from)
+	if len(conditions) > 0 {
+		query += " WHERE " + strings.Join(conditions, " AND ")
+	}
+	query += " GROUP BY " + column
+
+	var rows []struct {
+		Value sql.NullString `db:"value"`
+		Count int64          `db:"count"`
+	}
+	if err := db.SelectContext(ctx, &rows, query, params...); err != nil {
+		return nil, err
+	}
+	counts := make(map[string]int64, len(rows))
+	for _, r := range rows {
+		if r.Value.Valid {
+			counts[r.Value.String] = r.Count
+		}
+	}
+
+	values := make([]FacetCount, len(f.values))
+	for i, v := range f.values {
+		v.Count = counts[v.Value]
+		values[i] = v
+	}
+	return values, nil
+}
@@ -0,0 +1,288 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	importTargetChair  = "chair"
+	importTargetEstate = "estate"
+)
+
+// 非同期の入稿のジョブの状態
+const (
+	ImportJobPending   = "pending"
+	ImportJobRunning   = "running"
+	ImportJobSucceeded = "succeeded"
+	ImportJobFailed    = "failed"
+)
+
+// importJobPollInterval 通知がなくてもこの間隔でジョブを探す
+const importJobPollInterval = 10 * time.Second
+
+var importers = map[string]func(context.Context, echo.Logger, io.Reader, uploadOptions, func(int64)) (*UploadResponse, error){
+	importTargetChair:  importChairs,
+	importTargetEstate: importEstates,
+}
+
+var (
+	// importJobHost ジョブを処理するワーカーの名前。ファイルは受け付けたサーバーのIMPORT_JOB_DIRにしかないので、同じ名前のワーカーだけが処理する。
+	// IMPORT_JOB_HOSTで決める。コンテナを作り直すとホスト名が変わるので、決まった名前か共有のディレクトリと組み合わせて使う
+	importJobHost string
+	// importJobNotify ジョブを受け付けたらワーカーを起こす
+	importJobNotify = make(chan struct{}, 1)
+)
+
+// ImportJobResponse import_jobs/:idへのレスポンスの形式
+type ImportJobResponse struct {
+	ImportJob
+	RejectedLines []RejectedLine `json:"rejectedLines"`
+}
+
+func newImportJobResponse(job ImportJob) (ImportJobResponse, error) {
+	res := ImportJobResponse{ImportJob: job, RejectedLines: []RejectedLine{}}
+	if err := json.Unmarshal([]byte(job.RejectedLines), &res.RejectedLines); err != nil {
+		return res, fmt.Errorf("failed to decode rejected lines of import job %d : %w", job.ID, err)
+	}
+	return res, nil
+}
+
+// importJobDir アップロードされたファイルをジョブが終わるまで置くディレクトリ
+func importJobDir() string {
+	return getEnv("IMPORT_JOB_DIR", "../import_jobs")
+}
+
+// clearImportJobDir /initializeでimport_jobsを空にしたときに、残っているファイルも消す
+func clearImportJobDir() error {
+	entries, err := os.ReadDir(importJobDir())
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		if err := os.RemoveAll(filepath.Join(importJobDir(), entry.Name())); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// enqueueImportJob アップロードされたファイルを保存してジョブを作り、すぐに202を返す
+func enqueueImportJob(c echo.Context, target string, f io.Reader, opts uploadOptions) error {
+	dir := importJobDir()
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return errInternal(fmt.Errorf("failed to create import job dir : %w", err))
+	}
+	tmp, err := os.CreateTemp(dir, target+"-*."+string(opts.Format))
+	if err != nil {
+		return errInternal(fmt.Errorf("failed to create import job file : %w", err))
+	}
+	path, err := filepath.Abs(tmp.Name())
+	if err == nil {
+		_, err = io.Copy(tmp, f)
+	}
+	if cerr := tmp.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		os.Remove(tmp.Name())
+		return errInternal(fmt.Errorf("failed to save import job file : %w", err))
+	}
+
+	ctx := c.Request().Context()
+	now := time.Now()
+	var job ImportJob
+	query := `INSERT INTO import_jobs (target, format, mode, header, host, path, status, created_at, updated_at)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?) RETURNING *`
+	err = estateDB.GetContext(ctx, &job, query, target, string(opts.Format), string(opts.Mode), opts.Header, importJobHost, path, ImportJobPending, now, now)
+	if err != nil {
+		os.Remove(path)
+		return errInternal(fmt.Errorf("failed to insert import job : %w", err))
+	}
+	select {
+	case importJobNotify <- struct{}{}:
+	default:
+	}
+
+	res, err := newImportJobResponse(job)
+	if err != nil {
+		return errInternal(err)
+	}
+	c.Response().Header().Set(echo.HeaderLocation, fmt.Sprintf("/api/import_jobs/%d", job.ID))
+	return c.JSON(http.StatusAccepted, res)
+}
+
+func getImportJob(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.Echo().Logger.Infof("Request parameter \"id\" parse error : %v", err)
+		return newAPIError(http.StatusBadRequest, ErrCodeInvalidID, "id must be an integer")
+	}
+
+	job, err := NewImportJobSQL().Select().ID(id).SingleContext(c.Request().Context(), estateDB)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return newAPIError(http.StatusNotFound, ErrCodeImportJobNotFound, "import job not found")
+		}
+		return errInternal(fmt.Errorf("failed to get import job : %w", err))
+	}
+	res, err := newImportJobResponse(job)
+	if err != nil {
+		return errInternal(err)
+	}
+	return c.JSON(http.StatusOK, res)
+}
+
+// startImportJobWorker このサーバーのジョブを1件ずつ処理するワーカーを起動する
+func startImportJobWorker(logger echo.Logger) error {
+	host := os.Getenv("IMPORT_JOB_HOST")
+	if host == "" {
+		var err error
+		if host, err = os.Hostname(); err != nil {
+			return fmt.Errorf("failed to get hostname : %w", err)
+		}
+	}
+	importJobHost = host
+
+	ctx := context.Background()
+	go func() {
+		ticker := time.NewTicker(importJobPollInterval)
+		defer ticker.Stop()
+		reset := false
+		for {
+			// 古いDBではimport_jobsが/initializeまでないので、起動を止めずに作られるまで待つ
+			if !reset {
+				if err := resetRunningImportJobs(ctx); err != nil {
+					logger.Errorf("failed to reset running import jobs : %v", err)
+				} else {
+					reset = true
+				}
+			}
+			for reset {
+				ok, err := runNextImportJob(ctx, logger)
+				if err != nil {
+					logger.Errorf("failed to run import job : %v", err)
+				}
+				if !ok {
+					break
+				}
+			}
+			select {
+			case <-importJobNotify:
+			case <-ticker.C:
+			}
+		}
+	}()
+	return nil
+}
+
+// resetRunningImportJobs 前回止まったときに処理中だったジョブを待ちに戻す。
+// 書き込みのトランザクションごと戻っているので最初からやり直す
+func resetRunningImportJobs(ctx context.Context) error {
+	_, err := NewImportJobSQL().Update().
+		SetStatus(ImportJobPending).
+		SetRowsDone(0).
+		SetUpdatedAt(time.Now()).
+		WhereHost(importJobHost).
+		WhereStatus(ImportJobRunning).
+		ExecContext(ctx, estateDB)
+	return err
+}
+
+// runNextImportJob 待っているジョブを1件取って処理する。ジョブがなければfalseを返す
+func runNextImportJob(ctx context.Context, logger echo.Logger) (bool, error) {
+	var job ImportJob
+	query := `UPDATE import_jobs SET status = ?, updated_at = ?
+WHERE id = (SELECT id FROM import_jobs WHERE host = ? AND status = ? ORDER BY id LIMIT 1 FOR UPDATE SKIP LOCKED)
+RETURNING *`
+	err := estateDB.GetContext(ctx, &job, query, ImportJobRunning, time.Now(), importJobHost, ImportJobPending)
+	if err == sql.ErrNoRows {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to claim import job : %w", err)
+	}
+
+	q := NewImportJobSQL().Update().SetUpdatedAt(time.Now())
+	res, err := runImportJob(ctx, logger, job)
+	if err != nil {
+		q = q.SetStatus(ImportJobFailed).SetRowsDone(0).SetError(importJobErrorMessage(logger, job, err))
+	} else {
+		lines, err := json.Marshal(res.RejectedLines)
+		if err != nil {
+			return true, fmt.Errorf("failed to encode rejected lines of import job %d : %w", job.ID, err)
+		}
+		q = q.SetStatus(ImportJobSucceeded).
+			SetRowsDone(res.Inserted + res.Updated + res.Rejected).
+			SetInserted(res.Inserted).
+			SetUpdated(res.Updated).
+			SetRejected(res.Rejected).
+			SetRejectedLines(string(lines))
+	}
+	result, err := q.WhereID(job.ID).ExecContext(ctx, estateDB)
+	if err != nil {
+		return true, fmt.Errorf("failed to update import job %d : %w", job.ID, err)
+	}
+	if n, err := result.RowsAffected(); err == nil && n == 0 {
+		// 処理中に/initializeでimport_jobsが空になった。結果を残す先がないのでファイルだけ片付ける
+		logger.Infof("import job %d was removed while running", job.ID)
+	}
+	if err := os.Remove(job.Path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		logger.Errorf("failed to remove import job file %s : %v", job.Path, err)
+	}
+	return true, nil
+}
+
+func runImportJob(ctx context.Context, logger echo.Logger, job ImportJob) (*UploadResponse, error) {
+	importer, ok := importers[job.Target]
+	if !ok {
+		return nil, errInternal(fmt.Errorf("unknown import target : %q", job.Target))
+	}
+	f, err := os.Open(job.Path)
+	if errors.Is(err, os.ErrNotExist) {
+		// 別のサーバーやコンテナに移ったなどでファイルがなければ、待たせ続けずに失敗にする
+		logger.Errorf("import job %d file not found : %v", job.ID, err)
+		return nil, newAPIError(http.StatusGone, ErrCodeImportJobFileNotFound, "uploaded file is no longer available, upload it again")
+	}
+	if err != nil {
+		return nil, errInternal(fmt.Errorf("failed to open import job file : %w", err))
+	}
+	defer f.Close()
+
+	// 途中の行数はコミット前のものなので、失敗すれば0件に戻る
+	progress := func(done int64) {
+		_, err := NewImportJobSQL().Update().SetRowsDone(done).SetUpdatedAt(time.Now()).WhereID(job.ID).ExecContext(ctx, estateDB)
+		if err != nil {
+			logger.Errorf("failed to update progress of import job %d : %v", job.ID, err)
+		}
+	}
+	return importer(ctx, logger, f, uploadOptions{Format: uploadFormat(job.Format), Mode: uploadMode(job.Mode), Header: job.Header}, progress)
+}
+
+// importJobErrorMessage ジョブが失敗した理由を入稿した人に見せる形にする。500の原因はレスポンスには含めずログにだけ出す
+func importJobErrorMessage(logger echo.Logger, job ImportJob, err error) string {
+	var ae *APIError
+	if !errors.As(err, &ae) || ae.Status >= http.StatusInternalServerError {
+		logger.Errorf("import job %d failed : %v", job.ID, err)
+		return "internal server error"
+	}
+	msg := ae.Message
+	for _, fe := range ae.Errors {
+		msg += fmt.Sprintf("; %s %s", fe.Field, fe.Message)
+	}
+	return msg
+}
@@ -0,0 +1,2002 @@
+// Code generated by github.com/mackee/go-sqlla/v2/cmd/sqlla - DO NOT EDIT.
+package main
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"database/sql"
+	"time"
+
+	"github.com/mackee/go-sqlla/v2"
+)
+
+type importJobSQL struct {
+	where sqlla.Where
+}
+
+func NewImportJobSQL() importJobSQL {
+	q := importJobSQL{}
+	return q
+}
+
+var importJobAllColumns = []string{
+	"`id`", "`target`", "`format`", "`mode`", "`header`", "`host`", "`path`", "`status`", "`rows_done`", "`inserted`", "`updated`", "`rejected`", "`rejected_lines`", "`error`", "`created_at`", "`updated_at`",
+}
+
+type importJobSelectSQL struct {
+	importJobSQL
+	Columns     []string
+	order       string
+	limit       *uint64
+	offset      *uint64
+	tableAlias  string
+	joinClauses []string
+
+	additionalWhereClause     string
+	additionalWhereClauseArgs []interface{}
+
+	groupByColumns []string
+
+	isForUpdate bool
+}
+
+func (q importJobSQL) Select() importJobSelectSQL {
+	return importJobSelectSQL{
+		q,
+		importJobAllColumns,
+		"",
+		nil,
+		nil,
+		"",
+		nil,
+		"",
+		nil,
+		nil,
+		false,
+	}
+}
+
+func (q importJobSelectSQL) Or(qs ...importJobSelectSQL) importJobSelectSQL {
+	ws := make([]sqlla.Where, 0, len(qs))
+	for _, q := range qs {
+		ws = append(ws, q.where)
+	}
+	q.where = append(q.where, sqlla.ExprOr(ws))
+	return q
+}
+
+func (q importJobSelectSQL) Limit(l uint64) importJobSelectSQL {
+	q.limit = &l
+	return q
+}
+
+func (q importJobSelectSQL) Offset(o uint64) importJobSelectSQL {
+	q.offset = &o
+	return q
+}
+
+func (q importJobSelectSQL) ForUpdate() importJobSelectSQL {
+	q.isForUpdate = true
+	return q
+}
+
+func (q importJobSelectSQL) TableAlias(alias string) importJobSelectSQL {
+	q.tableAlias = "`" + alias + "`"
+	return q
+}
+
+func (q importJobSelectSQL) SetColumns(columns ...string) importJobSelectSQL {
+	q.Columns = make([]string, 0, len(columns))
+	for _, column := range columns {
+		if strings.ContainsAny(column, "(.`") {
+			q.Columns = append(q.Columns, column)
+		} else {
+			q.Columns = append(q.Columns, "`"+column+"`")
+		}
+	}
+	return q
+}
+
+func (q importJobSelectSQL) JoinClause(clause string) importJobSelectSQL {
+	q.joinClauses = append(q.joinClauses, clause)
+	return q
+}
+
+func (q importJobSelectSQL) AdditionalWhereClause(clause string, args ...interface{}) importJobSelectSQL {
+	q.additionalWhereClause = clause
+	q.additionalWhereClauseArgs = args
+	return q
+}
+
+func (q importJobSelectSQL) appendColumnPrefix(column string) string {
+	if q.tableAlias == "" || strings.ContainsAny(column, "(.") {
+		return column
+	}
+	return q.tableAlias + "." + column
+}
+
+func (q importJobSelectSQL) GroupBy(columns ...string) importJobSelectSQL {
+	q.groupByColumns = make([]string, 0, len(columns))
+	for _, column := range columns {
+		if strings.ContainsAny(column, "(.`") {
+			q.groupByColumns = append(q.groupByColumns, column)
+		} else {
+			q.groupByColumns = append(q.groupByColumns, "`"+column+"`")
+		}
+	}
+	return q
+}
+
+func (q importJobSelectSQL) ID(v int64, exprs ...sqlla.Operator) importJobSelectSQL {
+	var op sqlla.Operator
+	if len(exprs) == 0 {
+		op = sqlla.OpEqual
+	} else {
+		op = exprs[0]
+	}
+	where := sqlla.ExprInt64{Value: v, Op: op, Column: q.appendColumnPrefix("`id`")}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q importJobSelectSQL) IDIn(vs ...int64) importJobSelectSQL {
+	where := sqlla.ExprMultiInt64{Values: vs, Op: sqlla.MakeInOperator(len(vs)), Column: q.appendColumnPrefix("`id`")}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q importJobSelectSQL) OrderByID(order sqlla.Order) importJobSelectSQL {
+	q.order = " ORDER BY " + q.appendColumnPrefix("`id`")
+	if order == sqlla.Asc {
+		q.order += " ASC"
+	} else {
+		q.order += " DESC"
+	}
+
+	return q
+}
+
+func (q importJobSelectSQL) Target(v string, exprs ...sqlla.Operator) importJobSelectSQL {
+	var op sqlla.Operator
+	if len(exprs) == 0 {
+		op = sqlla.OpEqual
+	} else {
+		op = exprs[0]
+	}
+	where := sqlla.ExprString{Value: v, Op: op, Column: q.appendColumnPrefix("`target`")}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q importJobSelectSQL) TargetIn(vs ...string) importJobSelectSQL {
+	where := sqlla.ExprMultiString{Values: vs, Op: sqlla.MakeInOperator(len(vs)), Column: q.appendColumnPrefix("`target`")}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q importJobSelectSQL) OrderByTarget(order sqlla.Order) importJobSelectSQL {
+	q.order = " ORDER BY " + q.appendColumnPrefix("`target`")
+	if order == sqlla.Asc {
+		q.order += " ASC"
+	} else {
+		q.order += " DESC"
+	}
+
+	return q
+}
+
+func (q importJobSelectSQL) Format(v string, exprs ...sqlla.Operator) importJobSelectSQL {
+	var op sqlla.Operator
+	if len(exprs) == 0 {
+		op = sqlla.OpEqual
+	} else {
+		op = exprs[0]
+	}
+	where := sqlla.ExprString{Value: v, Op: op, Column: q.appendColumnPrefix("`format`")}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q importJobSelectSQL) FormatIn(vs ...string) importJobSelectSQL {
+	where := sqlla.ExprMultiString{Values: vs, Op: sqlla.MakeInOperator(len(vs)), Column: q.appendColumnPrefix("`format`")}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q importJobSelectSQL) OrderByFormat(order sqlla.Order) importJobSelectSQL {
+	q.order = " ORDER BY " + q.appendColumnPrefix("`format`")
+	if order == sqlla.Asc {
+		q.order += " ASC"
+	} else {
+		q.order += " DESC"
+	}
+
+	return q
+}
+
+func (q importJobSelectSQL) Mode(v string, exprs ...sqlla.Operator) importJobSelectSQL {
+	var op sqlla.Operator
+	if len(exprs) == 0 {
+		op = sqlla.OpEqual
+	} else {
+		op = exprs[0]
+	}
+	where := sqlla.ExprString{Value: v, Op: op, Column: q.appendColumnPrefix("`mode`")}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q importJobSelectSQL) ModeIn(vs ...string) importJobSelectSQL {
+	where := sqlla.ExprMultiString{Values: vs, Op: sqlla.MakeInOperator(len(vs)), Column: q.appendColumnPrefix("`mode`")}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q importJobSelectSQL) OrderByMode(order sqlla.Order) importJobSelectSQL {
+	q.order = " ORDER BY " + q.appendColumnPrefix("`mode`")
+	if order == sqlla.Asc {
+		q.order += " ASC"
+	} else {
+		q.order += " DESC"
+	}
+
+	return q
+}
+
+func (q importJobSelectSQL) Header(v bool, exprs ...sqlla.Operator) importJobSelectSQL {
+	var op sqlla.Operator
+	if len(exprs) == 0 {
+		op = sqlla.OpEqual
+	} else {
+		op = exprs[0]
+	}
+	where := sqlla.ExprBool{Value: v, Op: op, Column: q.appendColumnPrefix("`header`")}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q importJobSelectSQL) HeaderIn(vs ...bool) importJobSelectSQL {
+	where := sqlla.ExprMultiBool{Values: vs, Op: sqlla.MakeInOperator(len(vs)), Column: q.appendColumnPrefix("`header`")}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q importJobSelectSQL) OrderByHeader(order sqlla.Order) importJobSelectSQL {
+	q.order = " ORDER BY " + q.appendColumnPrefix("`header`")
+	if order == sqlla.Asc {
+		q.order += " ASC"
+	} else {
+		q.order += " DESC"
+	}
+
+	return q
+}
+
+func (q importJobSelectSQL) Host(v string, exprs ...sqlla.Operator) importJobSelectSQL {
+	var op sqlla.Operator
+	if len(exprs) == 0 {
+		op = sqlla.OpEqual
+	} else {
+		op = exprs[0]
+	}
+	where := sqlla.ExprString{Value: v, Op: op, Column: q.appendColumnPrefix("`host`")}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q importJobSelectSQL) HostIn(vs ...string) importJobSelectSQL {
+	where := sqlla.ExprMultiString{Values: vs, Op: sqlla.MakeInOperator(len(vs)), Column: q.appendColumnPrefix("`host`")}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q importJobSelectSQL) OrderByHost(order sqlla.Order) importJobSelectSQL {
+	q.order = " ORDER BY " + q.appendColumnPrefix("`host`")
+	if order == sqlla.Asc {
+		q.order += " ASC"
+	} else {
+		q.order += " DESC"
+	}
+
+	return q
+}
+
+func (q importJobSelectSQL) Path(v string, exprs ...sqlla.Operator) importJobSelectSQL {
+	var op sqlla.Operator
+	if len(exprs) == 0 {
+		op = sqlla.OpEqual
+	} else {
+		op = exprs[0]
+	}
+	where := sqlla.ExprString{Value: v, Op: op, Column: q.appendColumnPrefix("`path`")}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q importJobSelectSQL) PathIn(vs ...string) importJobSelectSQL {
+	where := sqlla.ExprMultiString{Values: vs, Op: sqlla.MakeInOperator(len(vs)), Column: q.appendColumnPrefix("`path`")}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q importJobSelectSQL) OrderByPath(order sqlla.Order) importJobSelectSQL {
+	q.order = " ORDER BY " + q.appendColumnPrefix("`path`")
+	if order == sqlla.Asc {
+		q.order += " ASC"
+	} else {
+		q.order += " DESC"
+	}
+
+	return q
+}
+
+func (q importJobSelectSQL) Status(v string, exprs ...sqlla.Operator) importJobSelectSQL {
+	var op sqlla.Operator
+	if len(exprs) == 0 {
+		op = sqlla.OpEqual
+	} else {
+		op = exprs[0]
+	}
+	where := sqlla.ExprString{Value: v, Op: op, Column: q.appendColumnPrefix("`status`")}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q importJobSelectSQL) StatusIn(vs ...string) importJobSelectSQL {
+	where := sqlla.ExprMultiString{Values: vs, Op: sqlla.MakeInOperator(len(vs)), Column: q.appendColumnPrefix("`status`")}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q importJobSelectSQL) OrderByStatus(order sqlla.Order) importJobSelectSQL {
+	q.order = " ORDER BY " + q.appendColumnPrefix("`status`")
+	if order == sqlla.Asc {
+		q.order += " ASC"
+	} else {
+		q.order += " DESC"
+	}
+
+	return q
+}
+
+func (q importJobSelectSQL) RowsDone(v int64, exprs ...sqlla.Operator) importJobSelectSQL {
+	var op sqlla.Operator
+	if len(exprs) == 0 {
+		op = sqlla.OpEqual
+	} else {
+		op = exprs[0]
+	}
+	where := sqlla.ExprInt64{Value: v, Op: op, Column: q.appendColumnPrefix("`rows_done`")}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q importJobSelectSQL) RowsDoneIn(vs ...int64) importJobSelectSQL {
+	where := sqlla.ExprMultiInt64{Values: vs, Op: sqlla.MakeInOperator(len(vs)), Column: q.appendColumnPrefix("`rows_done`")}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q importJobSelectSQL) OrderByRowsDone(order sqlla.Order) importJobSelectSQL {
+	q.order = " ORDER BY " + q.appendColumnPrefix("`rows_done`")
+	if order == sqlla.Asc {
+		q.order += " ASC"
+	} else {
+		q.order += " DESC"
+	}
+
+	return q
+}
+
+func (q importJobSelectSQL) Inserted(v int64, exprs ...sqlla.Operator) importJobSelectSQL {
+	var op sqlla.Operator
+	if len(exprs) == 0 {
+		op = sqlla.OpEqual
+	} else {
+		op = exprs[0]
+	}
+	where := sqlla.ExprInt64{Value: v, Op: op, Column: q.appendColumnPrefix("`inserted`")}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q importJobSelectSQL) InsertedIn(vs ...int64) importJobSelectSQL {
+	where := sqlla.ExprMultiInt64{Values: vs, Op: sqlla.MakeInOperator(len(vs)), Column: q.appendColumnPrefix("`inserted`")}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q importJobSelectSQL) OrderByInserted(order sqlla.Order) importJobSelectSQL {
+	q.order = " ORDER BY " + q.appendColumnPrefix("`inserted`")
+	if order == sqlla.Asc {
+		q.order += " ASC"
+	} else {
+		q.order += " DESC"
+	}
+
+	return q
+}
+
+func (q importJobSelectSQL) Updated(v int64, exprs ...sqlla.Operator) importJobSelectSQL {
+	var op sqlla.Operator
+	if len(exprs) == 0 {
+		op = sqlla.OpEqual
+	} else {
+		op = exprs[0]
+	}
+	where := sqlla.ExprInt64{Value: v, Op: op, Column: q.appendColumnPrefix("`updated`")}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q importJobSelectSQL) UpdatedIn(vs ...int64) importJobSelectSQL {
+	where := sqlla.ExprMultiInt64{Values: vs, Op: sqlla.MakeInOperator(len(vs)), Column: q.appendColumnPrefix("`updated`")}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q importJobSelectSQL) OrderByUpdated(order sqlla.Order) importJobSelectSQL {
+	q.order = " ORDER BY " + q.appendColumnPrefix("`updated`")
+	if order == sqlla.Asc {
+		q.order += " ASC"
+	} else {
+		q.order += " DESC"
+	}
+
+	return q
+}
+
+func (q importJobSelectSQL) Rejected(v int64, exprs ...sqlla.Operator) importJobSelectSQL {
+	var op sqlla.Operator
+	if len(exprs) == 0 {
+		op = sqlla.OpEqual
+	} else {
+		op = exprs[0]
+	}
+	where := sqlla.ExprInt64{Value: v, Op: op, Column: q.appendColumnPrefix("`rejected`")}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q importJobSelectSQL) RejectedIn(vs ...int64) importJobSelectSQL {
+	where := sqlla.ExprMultiInt64{Values: vs, Op: sqlla.MakeInOperator(len(vs)), Column: q.appendColumnPrefix("`rejected`")}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q importJobSelectSQL) OrderByRejected(order sqlla.Order) importJobSelectSQL {
+	q.order = " ORDER BY " + q.appendColumnPrefix("`rejected`")
+	if order == sqlla.Asc {
+		q.order += " ASC"
+	} else {
+		q.order += " DESC"
+	}
+
+	return q
+}
+
+func (q importJobSelectSQL) RejectedLines(v string, exprs ...sqlla.Operator) importJobSelectSQL {
+	var op sqlla.Operator
+	if len(exprs) == 0 {
+		op = sqlla.OpEqual
+	} else {
+		op = exprs[0]
+	}
+	where := sqlla.ExprString{Value: v, Op: op, Column: q.appendColumnPrefix("`rejected_lines`")}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q importJobSelectSQL) RejectedLinesIn(vs ...string) importJobSelectSQL {
+	where := sqlla.ExprMultiString{Values: vs, Op: sqlla.MakeInOperator(len(vs)), Column: q.appendColumnPrefix("`rejected_lines`")}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q importJobSelectSQL) OrderByRejectedLines(order sqlla.Order) importJobSelectSQL {
+	q.order = " ORDER BY " + q.appendColumnPrefix("`rejected_lines`")
+	if order == sqlla.Asc {
+		q.order += " ASC"
+	} else {
+		q.order += " DESC"
+	}
+
+	return q
+}
+
+func (q importJobSelectSQL) Error(v string, exprs ...sqlla.Operator) importJobSelectSQL {
+	var op sqlla.Operator
+	if len(exprs) == 0 {
+		op = sqlla.OpEqual
+	} else {
+		op = exprs[0]
+	}
+	where := sqlla.ExprString{Value: v, Op: op, Column: q.appendColumnPrefix("`error`")}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q importJobSelectSQL) ErrorIn(vs ...string) importJobSelectSQL {
+	where := sqlla.ExprMultiString{Values: vs, Op: sqlla.MakeInOperator(len(vs)), Column: q.appendColumnPrefix("`error`")}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q importJobSelectSQL) OrderByError(order sqlla.Order) importJobSelectSQL {
+	q.order = " ORDER BY " + q.appendColumnPrefix("`error`")
+	if order == sqlla.Asc {
+		q.order += " ASC"
+	} else {
+		q.order += " DESC"
+	}
+
+	return q
+}
+
+func (q importJobSelectSQL) CreatedAt(v time.Time, exprs ...sqlla.Operator) importJobSelectSQL {
+	var op sqlla.Operator
+	if len(exprs) == 0 {
+		op = sqlla.OpEqual
+	} else {
+		op = exprs[0]
+	}
+	where := sqlla.ExprTime{Value: v, Op: op, Column: q.appendColumnPrefix("`created_at`")}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q importJobSelectSQL) CreatedAtIn(vs ...time.Time) importJobSelectSQL {
+	where := sqlla.ExprMultiTime{Values: vs, Op: sqlla.MakeInOperator(len(vs)), Column: q.appendColumnPrefix("`created_at`")}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q importJobSelectSQL) OrderByCreatedAt(order sqlla.Order) importJobSelectSQL {
+	q.order = " ORDER BY " + q.appendColumnPrefix("`created_at`")
+	if order == sqlla.Asc {
+		q.order += " ASC"
+	} else {
+		q.order += " DESC"
+	}
+
+	return q
+}
+
+func (q importJobSelectSQL) UpdatedAt(v time.Time, exprs ...sqlla.Operator) importJobSelectSQL {
+	var op sqlla.Operator
+	if len(exprs) == 0 {
+		op = sqlla.OpEqual
+	} else {
+		op = exprs[0]
+	}
+	where := sqlla.ExprTime{Value: v, Op: op, Column: q.appendColumnPrefix("`updated_at`")}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q importJobSelectSQL) UpdatedAtIn(vs ...time.Time) importJobSelectSQL {
+	where := sqlla.ExprMultiTime{Values: vs, Op: sqlla.MakeInOperator(len(vs)), Column: q.appendColumnPrefix("`updated_at`")}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q importJobSelectSQL) OrderByUpdatedAt(order sqlla.Order) importJobSelectSQL {
+	q.order = " ORDER BY " + q.appendColumnPrefix("`updated_at`")
+	if order == sqlla.Asc {
+		q.order += " ASC"
+	} else {
+		q.order += " DESC"
+	}
+
+	return q
+}
+
+func (q importJobSelectSQL) ToSql() (string, []interface{}, error) {
+	columns := strings.Join(q.Columns, ", ")
+	wheres, vs, err := q.where.ToSql()
+	if err != nil {
+		return "", nil, err
+	}
+
+	tableName := "import_jobs"
+	if q.tableAlias != "" {
+		tableName = tableName + " AS " + q.tableAlias
+		pcs := make([]string, 0, len(q.Columns))
+		for _, column := range q.Columns {
+			pcs = append(pcs, q.appendColumnPrefix(column))
+		}
+		columns = strings.Join(pcs, ", ")
+	}
+	query := "SELECT " + columns + " FROM " + tableName
+	if len(q.joinClauses) > 0 {
+		jc := strings.Join(q.joinClauses, " ")
+		query += " " + jc
+	}
+	if wheres != "" {
+		query += " WHERE" + wheres
+	}
+	if q.additionalWhereClause != "" {
+		query += " " + q.additionalWhereClause
+		if len(q.additionalWhereClauseArgs) > 0 {
+			vs = append(vs, q.additionalWhereClauseArgs...)
+		}
+	}
+	if len(q.groupByColumns) > 0 {
+		query += " GROUP BY "
+		gbcs := make([]string, 0, len(q.groupByColumns))
+		for _, column := range q.groupByColumns {
+			gbcs = append(gbcs, q.appendColumnPrefix(column))
+		}
+		query += strings.Join(gbcs, ", ")
+	}
+	query += q.order
+	if q.limit != nil {
+		query += " LIMIT " + strconv.FormatUint(*q.limit, 10)
+	}
+	if q.offset != nil {
+		query += " OFFSET " + strconv.FormatUint(*q.offset, 10)
+	}
+
+	if q.isForUpdate {
+		query += " FOR UPDATE"
+	}
+
+	return query + ";", vs, nil
+}
+
+func (q importJobSelectSQL) Single(db sqlla.DB) (ImportJob, error) {
+	q.Columns = importJobAllColumns
+	query, args, err := q.ToSql()
+	if err != nil {
+		return ImportJob{}, err
+	}
+
+	row := db.QueryRow(query, args...)
+	return q.Scan(row)
+}
+
+func (q importJobSelectSQL) SingleContext(ctx context.Context, db sqlla.DB) (ImportJob, error) {
+	q.Columns = importJobAllColumns
+	query, args, err := q.ToSql()
+	if err != nil {
+		return ImportJob{}, err
+	}
+
+	row := db.QueryRowContext(ctx, query, args...)
+	return q.Scan(row)
+}
+
+func (q importJobSelectSQL) All(db sqlla.DB) ([]ImportJob, error) {
+	rs := make([]ImportJob, 0, 10)
+	q.Columns = importJobAllColumns
+	query, args, err := q.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		r, err := q.Scan(rows)
+		if err != nil {
+			return nil, err
+		}
+		rs = append(rs, r)
+	}
+	return rs, nil
+}
+
+func (q importJobSelectSQL) AllContext(ctx context.Context, db sqlla.DB) ([]ImportJob, error) {
+	rs := make([]ImportJob, 0, 10)
+	q.Columns = importJobAllColumns
+	query, args, err := q.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		r, err := q.Scan(rows)
+		if err != nil {
+			return nil, err
+		}
+		rs = append(rs, r)
+	}
+	return rs, nil
+}
+
+func (q importJobSelectSQL) Scan(s sqlla.Scanner) (ImportJob, error) {
+	var row ImportJob
+	err := s.Scan(
+		&row.ID,
+		&row.Target,
+		&row.Format,
+		&row.Mode,
+		&row.Header,
+		&row.Host,
+		&row.Path,
+		&row.Status,
+		&row.RowsDone,
+		&row.Inserted,
+		&row.Updated,
+		&row.Rejected,
+		&row.RejectedLines,
+		&row.Error,
+		&row.CreatedAt,
+		&row.UpdatedAt,
+	)
+	return row, err
+}
+
+type importJobUpdateSQL struct {
+	importJobSQL
+	setMap  sqlla.SetMap
+	Columns []string
+}
+
+func (q importJobSQL) Update() importJobUpdateSQL {
+	return importJobUpdateSQL{
+		importJobSQL: q,
+		setMap:       sqlla.SetMap{},
+	}
+}
+
+func (q importJobUpdateSQL) SetID(v int64) importJobUpdateSQL {
+	q.setMap["`id`"] = v
+	return q
+}
+
+func (q importJobUpdateSQL) WhereID(v int64, exprs ...sqlla.Operator) importJobUpdateSQL {
+	var op sqlla.Operator
+	if len(exprs) == 0 {
+		op = sqlla.OpEqual
+	} else {
+		op = exprs[0]
+	}
+	where := sqlla.ExprInt64{Value: v, Op: op, Column: "`id`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q importJobUpdateSQL) WhereIDIn(vs ...int64) importJobUpdateSQL {
+	where := sqlla.ExprMultiInt64{Values: vs, Op: sqlla.MakeInOperator(len(vs)), Column: "`id`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q importJobUpdateSQL) SetTarget(v string) importJobUpdateSQL {
+	q.setMap["`target`"] = v
+	return q
+}
+
+func (q importJobUpdateSQL) WhereTarget(v string, exprs ...sqlla.Operator) importJobUpdateSQL {
+	var op sqlla.Operator
+	if len(exprs) == 0 {
+		op = sqlla.OpEqual
+	} else {
+		op = exprs[0]
+	}
+	where := sqlla.ExprString{Value: v, Op: op, Column: "`target`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q importJobUpdateSQL) WhereTargetIn(vs ...string) importJobUpdateSQL {
+	where := sqlla.ExprMultiString{Values: vs, Op: sqlla.MakeInOperator(len(vs)), Column: "`target`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q importJobUpdateSQL) SetFormat(v string) importJobUpdateSQL {
+	q.setMap["`format`"] = v
+	return q
+}
+
+func (q importJobUpdateSQL) WhereFormat(v string, exprs ...sqlla.Operator) importJobUpdateSQL {
+	var op sqlla.Operator
+	if len(exprs) == 0 {
+		op = sqlla.OpEqual
+	} else {
+		op = exprs[0]
+	}
+	where := sqlla.ExprString{Value: v, Op: op, Column: "`format`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q importJobUpdateSQL) WhereFormatIn(vs ...string) importJobUpdateSQL {
+	where := sqlla.ExprMultiString{Values: vs, Op: sqlla.MakeInOperator(len(vs)), Column: "`format`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q importJobUpdateSQL) SetMode(v string) importJobUpdateSQL {
+	q.setMap["`mode`"] = v
+	return q
+}
+
+func (q importJobUpdateSQL) WhereMode(v string, exprs ...sqlla.Operator) importJobUpdateSQL {
+	var op sqlla.Operator
+	if len(exprs) == 0 {
+		op = sqlla.OpEqual
+	} else {
+		op = exprs[0]
+	}
+	where := sqlla.ExprString{Value: v, Op: op, Column: "`mode`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q importJobUpdateSQL) WhereModeIn(vs ...string) importJobUpdateSQL {
+	where := sqlla.ExprMultiString{Values: vs, Op: sqlla.MakeInOperator(len(vs)), Column: "`mode`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q importJobUpdateSQL) SetHeader(v bool) importJobUpdateSQL {
+	q.setMap["`header`"] = v
+	return q
+}
+
+func (q importJobUpdateSQL) WhereHeader(v bool, exprs ...sqlla.Operator) importJobUpdateSQL {
+	var op sqlla.Operator
+	if len(exprs) == 0 {
+		op = sqlla.OpEqual
+	} else {
+		op = exprs[0]
+	}
+	where := sqlla.ExprBool{Value: v, Op: op, Column: "`header`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q importJobUpdateSQL) WhereHeaderIn(vs ...bool) importJobUpdateSQL {
+	where := sqlla.ExprMultiBool{Values: vs, Op: sqlla.MakeInOperator(len(vs)), Column: "`header`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q importJobUpdateSQL) SetHost(v string) importJobUpdateSQL {
+	q.setMap["`host`"] = v
+	return q
+}
+
+func (q importJobUpdateSQL) WhereHost(v string, exprs ...sqlla.Operator) importJobUpdateSQL {
+	var op sqlla.Operator
+	if len(exprs) == 0 {
+		op = sqlla.OpEqual
+	} else {
+		op = exprs[0]
+	}
+	where := sqlla.ExprString{Value: v, Op: op, Column: "`host`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q importJobUpdateSQL) WhereHostIn(vs ...string) importJobUpdateSQL {
+	where := sqlla.ExprMultiString{Values: vs, Op: sqlla.MakeInOperator(len(vs)), Column: "`host`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q importJobUpdateSQL) SetPath(v string) importJobUpdateSQL {
+	q.setMap["`path`"] = v
+	return q
+}
+
+func (q importJobUpdateSQL) WherePath(v string, exprs ...sqlla.Operator) importJobUpdateSQL {
+	var op sqlla.Operator
+	if len(exprs) == 0 {
+		op = sqlla.OpEqual
+	} else {
+		op = exprs[0]
+	}
+	where := sqlla.ExprString{Value: v, Op: op, Column: "`path`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q importJobUpdateSQL) WherePathIn(vs ...string) importJobUpdateSQL {
+	where := sqlla.ExprMultiString{Values: vs, Op: sqlla.MakeInOperator(len(vs)), Column: "`path`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q importJobUpdateSQL) SetStatus(v string) importJobUpdateSQL {
+	q.setMap["`status`"] = v
+	return q
+}
+
+func (q importJobUpdateSQL) WhereStatus(v string, exprs ...sqlla.Operator) importJobUpdateSQL {
+	var op sqlla.Operator
+	if len(exprs) == 0 {
+		op = sqlla.OpEqual
+	} else {
+		op = exprs[0]
+	}
+	where := sqlla.ExprString{Value: v, Op: op, Column: "`status`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q importJobUpdateSQL) WhereStatusIn(vs ...string) importJobUpdateSQL {
+	where := sqlla.ExprMultiString{Values: vs, Op: sqlla.MakeInOperator(len(vs)), Column: "`status`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q importJobUpdateSQL) SetRowsDone(v int64) importJobUpdateSQL {
+	q.setMap["`rows_done`"] = v
+	return q
+}
+
+func (q importJobUpdateSQL) WhereRowsDone(v int64, exprs ...sqlla.Operator) importJobUpdateSQL {
+	var op sqlla.Operator
+	if len(exprs) == 0 {
+		op = sqlla.OpEqual
+	} else {
+		op = exprs[0]
+	}
+	where := sqlla.ExprInt64{Value: v, Op: op, Column: "`rows_done`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q importJobUpdateSQL) WhereRowsDoneIn(vs ...int64) importJobUpdateSQL {
+	where := sqlla.ExprMultiInt64{Values: vs, Op: sqlla.MakeInOperator(len(vs)), Column: "`rows_done`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q importJobUpdateSQL) SetInserted(v int64) importJobUpdateSQL {
+	q.setMap["`inserted`"] = v
+	return q
+}
+
+func (q importJobUpdateSQL) WhereInserted(v int64, exprs ...sqlla.Operator) importJobUpdateSQL {
+	var op sqlla.Operator
+	if len(exprs) == 0 {
+		op = sqlla.OpEqual
+	} else {
+		op = exprs[0]
+	}
+	where := sqlla.ExprInt64{Value: v, Op: op, Column: "`inserted`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q importJobUpdateSQL) WhereInsertedIn(vs ...int64) importJobUpdateSQL {
+	where := sqlla.ExprMultiInt64{Values: vs, Op: sqlla.MakeInOperator(len(vs)), Column: "`inserted`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q importJobUpdateSQL) SetUpdated(v int64) importJobUpdateSQL {
+	q.setMap["`updated`"] = v
+	return q
+}
+
+func (q importJobUpdateSQL) WhereUpdated(v int64, exprs ...sqlla.Operator) importJobUpdateSQL {
+	var op sqlla.Operator
+	if len(exprs) == 0 {
+		op = sqlla.OpEqual
+	} else {
+		op = exprs[0]
+	}
+	where := sqlla.ExprInt64{Value: v, Op: op, Column: "`updated`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q importJobUpdateSQL) WhereUpdatedIn(vs ...int64) importJobUpdateSQL {
+	where := sqlla.ExprMultiInt64{Values: vs, Op: sqlla.MakeInOperator(len(vs)), Column: "`updated`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q importJobUpdateSQL) SetRejected(v int64) importJobUpdateSQL {
+	q.setMap["`rejected`"] = v
+	return q
+}
+
+func (q importJobUpdateSQL) WhereRejected(v int64, exprs ...sqlla.Operator) importJobUpdateSQL {
+	var op sqlla.Operator
+	if len(exprs) == 0 {
+		op = sqlla.OpEqual
+	} else {
+		op = exprs[0]
+	}
+	where := sqlla.ExprInt64{Value: v, Op: op, Column: "`rejected`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q importJobUpdateSQL) WhereRejectedIn(vs ...int64) importJobUpdateSQL {
+	where := sqlla.ExprMultiInt64{Values: vs, Op: sqlla.MakeInOperator(len(vs)), Column: "`rejected`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q importJobUpdateSQL) SetRejectedLines(v string) importJobUpdateSQL {
+	q.setMap["`rejected_lines`"] = v
+	return q
+}
+
+func (q importJobUpdateSQL) WhereRejectedLines(v string, exprs ...sqlla.Operator) importJobUpdateSQL {
+	var op sqlla.Operator
+	if len(exprs) == 0 {
+		op = sqlla.OpEqual
+	} else {
+		op = exprs[0]
+	}
+	where := sqlla.ExprString{Value: v, Op: op, Column: "`rejected_lines`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q importJobUpdateSQL) WhereRejectedLinesIn(vs ...string) importJobUpdateSQL {
+	where := sqlla.ExprMultiString{Values: vs, Op: sqlla.MakeInOperator(len(vs)), Column: "`rejected_lines`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q importJobUpdateSQL) SetError(v string) importJobUpdateSQL {
+	q.setMap["`error`"] = v
+	return q
+}
+
+func (q importJobUpdateSQL) WhereError(v string, exprs ...sqlla.Operator) importJobUpdateSQL {
+	var op sqlla.Operator
+	if len(exprs) == 0 {
+		op = sqlla.OpEqual
+	} else {
+		op = exprs[0]
+	}
+	where := sqlla.ExprString{Value: v, Op: op, Column: "`error`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q importJobUpdateSQL) WhereErrorIn(vs ...string) importJobUpdateSQL {
+	where := sqlla.ExprMultiString{Values: vs, Op: sqlla.MakeInOperator(len(vs)), Column: "`error`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q importJobUpdateSQL) SetCreatedAt(v time.Time) importJobUpdateSQL {
+	q.setMap["`created_at`"] = v
+	return q
+}
+
+func (q importJobUpdateSQL) WhereCreatedAt(v time.Time, exprs ...sqlla.Operator) importJobUpdateSQL {
+	var op sqlla.Operator
+	if len(exprs) == 0 {
+		op = sqlla.OpEqual
+	} else {
+		op = exprs[0]
+	}
+	where := sqlla.ExprTime{Value: v, Op: op, Column: "`created_at`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q importJobUpdateSQL) WhereCreatedAtIn(vs ...time.Time) importJobUpdateSQL {
+	where := sqlla.ExprMultiTime{Values: vs, Op: sqlla.MakeInOperator(len(vs)), Column: "`created_at`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q importJobUpdateSQL) SetUpdatedAt(v time.Time) importJobUpdateSQL {
+	q.setMap["`updated_at`"] = v
+	return q
+}
+
+func (q importJobUpdateSQL) WhereUpdatedAt(v time.Time, exprs ...sqlla.Operator) importJobUpdateSQL {
+	var op sqlla.Operator
+	if len(exprs) == 0 {
+		op = sqlla.OpEqual
+	} else {
+		op = exprs[0]
+	}
+	where := sqlla.ExprTime{Value: v, Op: op, Column: "`updated_at`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q importJobUpdateSQL) WhereUpdatedAtIn(vs ...time.Time) importJobUpdateSQL {
+	where := sqlla.ExprMultiTime{Values: vs, Op: sqlla.MakeInOperator(len(vs)), Column: "`updated_at`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q importJobUpdateSQL) ToSql() (string, []interface{}, error) {
+	var err error
+	var s interface{} = ImportJob{}
+	if t, ok := s.(importJobDefaultUpdateHooker); ok {
+		q, err = t.DefaultUpdateHook(q)
+		if err != nil {
+			return "", []interface{}{}, err
+		}
+	}
+	setColumns, svs, err := q.setMap.ToUpdateSql()
+	if err != nil {
+		return "", []interface{}{}, err
+	}
+	wheres, wvs, err := q.where.ToSql()
+	if err != nil {
+		return "", []interface{}{}, err
+	}
+
+	query := "UPDATE import_jobs SET" + setColumns
+	if wheres != "" {
+		query += " WHERE" + wheres
+	}
+
+	return query + ";", append(svs, wvs...), nil
+}
+func (q importJobUpdateSQL) Exec(db sqlla.DB) (sql.Result, error) {
+	query, args, err := q.ToSql()
+	if err != nil {
+		return nil, err
+	}
+	return db.Exec(query, args...)
+}
+
+func (q importJobUpdateSQL) ExecContext(ctx context.Context, db sqlla.DB) (sql.Result, error) {
+	query, args, err := q.ToSql()
+	if err != nil {
+		return nil, err
+	}
+	return db.ExecContext(ctx, query, args...)
+}
+
+type importJobDefaultUpdateHooker interface {
+	DefaultUpdateHook(importJobUpdateSQL) (importJobUpdateSQL, error)
+}
+
+type importJobInsertSQL struct {
+	importJobSQL
+	setMap  sqlla.SetMap
+	Columns []string
+}
+
+func (q importJobSQL) Insert() importJobInsertSQL {
+	return importJobInsertSQL{
+		importJobSQL: q,
+		setMap:       sqlla.SetMap{},
+	}
+}
+
+func (q importJobInsertSQL) ValueID(v int64) importJobInsertSQL {
+	q.setMap["`id`"] = v
+	return q
+}
+
+func (q importJobInsertSQL) ValueTarget(v string) importJobInsertSQL {
+	q.setMap["`target`"] = v
+	return q
+}
+
+func (q importJobInsertSQL) ValueFormat(v string) importJobInsertSQL {
+	q.setMap["`format`"] = v
+	return q
+}
+
+func (q importJobInsertSQL) ValueMode(v string) importJobInsertSQL {
+	q.setMap["`mode`"] = v
+	return q
+}
+
+func (q importJobInsertSQL) ValueHeader(v bool) importJobInsertSQL {
+	q.setMap["`header`"] = v
+	return q
+}
+
+func (q importJobInsertSQL) ValueHost(v string) importJobInsertSQL {
+	q.setMap["`host`"] = v
+	return q
+}
+
+func (q importJobInsertSQL) ValuePath(v string) importJobInsertSQL {
+	q.setMap["`path`"] = v
+	return q
+}
+
+func (q importJobInsertSQL) ValueStatus(v string) importJobInsertSQL {
+	q.setMap["`status`"] = v
+	return q
+}
+
+func (q importJobInsertSQL) ValueRowsDone(v int64) importJobInsertSQL {
+	q.setMap["`rows_done`"] = v
+	return q
+}
+
+func (q importJobInsertSQL) ValueInserted(v int64) importJobInsertSQL {
+	q.setMap["`inserted`"] = v
+	return q
+}
+
+func (q importJobInsertSQL) ValueUpdated(v int64) importJobInsertSQL {
+	q.setMap["`updated`"] = v
+	return q
+}
+
+func (q importJobInsertSQL) ValueRejected(v int64) importJobInsertSQL {
+	q.setMap["`rejected`"] = v
+	return q
+}
+
+func (q importJobInsertSQL) ValueRejectedLines(v string) importJobInsertSQL {
+	q.setMap["`rejected_lines`"] = v
+	return q
+}
+
+func (q importJobInsertSQL) ValueError(v string) importJobInsertSQL {
+	q.setMap["`error`"] = v
+	return q
+}
+
+func (q importJobInsertSQL) ValueCreatedAt(v time.Time) importJobInsertSQL {
+	q.setMap["`created_at`"] = v
+	return q
+}
+
+func (q importJobInsertSQL) ValueUpdatedAt(v time.Time) importJobInsertSQL {
+	q.setMap["`updated_at`"] = v
+	return q
+}
+
+func (q importJobInsertSQL) ToSql() (string, []interface{}, error) {
+	query, vs, err := q.importJobInsertSQLToSql()
+	if err != nil {
+		return "", []interface{}{}, err
+	}
+	return query + ";", vs, nil
+}
+
+func (q importJobInsertSQL) importJobInsertSQLToSql() (string, []interface{}, error) {
+	var err error
+	var s interface{} = ImportJob{}
+	if t, ok := s.(importJobDefaultInsertHooker); ok {
+		q, err = t.DefaultInsertHook(q)
+		if err != nil {
+			return "", []interface{}{}, err
+		}
+	}
+	qs, vs, err := q.setMap.ToInsertSql()
+	if err != nil {
+		return "", []interface{}{}, err
+	}
+
+	query := "INSERT INTO import_jobs " + qs
+
+	return query, vs, nil
+}
+
+func (q importJobInsertSQL) OnDuplicateKeyUpdate() importJobInsertOnDuplicateKeyUpdateSQL {
+	return importJobInsertOnDuplicateKeyUpdateSQL{
+		insertSQL:               q,
+		onDuplicateKeyUpdateMap: sqlla.SetMap{},
+	}
+}
+
+func (q importJobInsertSQL) Exec(db sqlla.DB) (sql.Result, error) {
+	query, args, err := q.ToSql()
+	if err != nil {
+
+		return nil, err
+	}
+	result, err := db.Exec(query, args...)
+	return result, err
+}
+
+func (q importJobInsertSQL) ExecContext(ctx context.Context, db sqlla.DB) (sql.Result, error) {
+	query, args, err := q.ToSql()
+	if err != nil {
+
+		return nil, err
+	}
+	result, err := db.ExecContext(ctx, query, args...)
+	return result, err
+}
+
+type importJobDefaultInsertHooker interface {
+	DefaultInsertHook(importJobInsertSQL) (importJobInsertSQL, error)
+}
+
+type importJobInsertSQLToSqler interface {
+	importJobInsertSQLToSql() (string, []interface{}, error)
+}
+
+type importJobInsertOnDuplicateKeyUpdateSQL struct {
+	insertSQL               importJobInsertSQLToSqler
+	onDuplicateKeyUpdateMap sqlla.SetMap
+}
+
+func (q importJobInsertOnDuplicateKeyUpdateSQL) ValueOnUpdateID(v int64) importJobInsertOnDuplicateKeyUpdateSQL {
+	q.onDuplicateKeyUpdateMap["`id`"] = v
+	return q
+}
+
+func (q importJobInsertOnDuplicateKeyUpdateSQL) RawValueOnUpdateID(v sqlla.SetMapRawValue) importJobInsertOnDuplicateKeyUpdateSQL {
+	q.onDuplicateKeyUpdateMap["`id`"] = v
+	return q
+}
+
+func (q importJobInsertOnDuplicateKeyUpdateSQL) SameOnUpdateID() importJobInsertOnDuplicateKeyUpdateSQL {
+	q.onDuplicateKeyUpdateMap["`id`"] = sqlla.SetMapRawValue("VALUES(`id`)")
+	return q
+}
+
+func (q importJobInsertOnDuplicateKeyUpdateSQL) ValueOnUpdateTarget(v string) importJobInsertOnDuplicateKeyUpdateSQL {
+	q.onDuplicateKeyUpdateMap["`target`"] = v
+	return q
+}
+
+func (q importJobInsertOnDuplicateKeyUpdateSQL) RawValueOnUpdateTarget(v sqlla.SetMapRawValue) importJobInsertOnDuplicateKeyUpdateSQL {
+	q.onDuplicateKeyUpdateMap["`target`"] = v
+	return q
+}
+
+func (q importJobInsertOnDuplicateKeyUpdateSQL) SameOnUpdateTarget() importJobInsertOnDuplicateKeyUpdateSQL {
+	q.onDuplicateKeyUpdateMap["`target`"] = sqlla.SetMapRawValue("VALUES(`target`)")
+	return q
+}
+
+func (q importJobInsertOnDuplicateKeyUpdateSQL) ValueOnUpdateFormat(v string) importJobInsertOnDuplicateKeyUpdateSQL {
+	q.onDuplicateKeyUpdateMap["`format`"] = v
+	return q
+}
+
+func (q importJobInsertOnDuplicateKeyUpdateSQL) RawValueOnUpdateFormat(v sqlla.SetMapRawValue) importJobInsertOnDuplicateKeyUpdateSQL {
+	q.onDuplicateKeyUpdateMap["`format`"] = v
+	return q
+}
+
+func (q importJobInsertOnDuplicateKeyUpdateSQL) SameOnUpdateFormat() importJobInsertOnDuplicateKeyUpdateSQL {
+	q.onDuplicateKeyUpdateMap["`format`"] = sqlla.SetMapRawValue("VALUES(`format`)")
+	return q
+}
+
+func (q importJobInsertOnDuplicateKeyUpdateSQL) ValueOnUpdateMode(v string) importJobInsertOnDuplicateKeyUpdateSQL {
+	q.onDuplicateKeyUpdateMap["`mode`"] = v
+	return q
+}
+
+func (q importJobInsertOnDuplicateKeyUpdateSQL) RawValueOnUpdateMode(v sqlla.SetMapRawValue) importJobInsertOnDuplicateKeyUpdateSQL {
+	q.onDuplicateKeyUpdateMap["`mode`"] = v
+	return q
+}
+
+func (q importJobInsertOnDuplicateKeyUpdateSQL) SameOnUpdateMode() importJobInsertOnDuplicateKeyUpdateSQL {
+	q.onDuplicateKeyUpdateMap["`mode`"] = sqlla.SetMapRawValue("VALUES(`mode`)")
+	return q
+}
+
+func (q importJobInsertOnDuplicateKeyUpdateSQL) ValueOnUpdateHeader(v bool) importJobInsertOnDuplicateKeyUpdateSQL {
+	q.onDuplicateKeyUpdateMap["`header`"] = v
+	return q
+}
+
+func (q importJobInsertOnDuplicateKeyUpdateSQL) RawValueOnUpdateHeader(v sqlla.SetMapRawValue) importJobInsertOnDuplicateKeyUpdateSQL {
+	q.onDuplicateKeyUpdateMap["`header`"] = v
+	return q
+}
+
+func (q importJobInsertOnDuplicateKeyUpdateSQL) SameOnUpdateHeader() importJobInsertOnDuplicateKeyUpdateSQL {
+	q.onDuplicateKeyUpdateMap["`header`"] = sqlla.SetMapRawValue("VALUES(`header`)")
+	return q
+}
+
+func (q importJobInsertOnDuplicateKeyUpdateSQL) ValueOnUpdateHost(v string) importJobInsertOnDuplicateKeyUpdateSQL {
+	q.onDuplicateKeyUpdateMap["`host`"] = v
+	return q
+}
+
+func (q importJobInsertOnDuplicateKeyUpdateSQL) RawValueOnUpdateHost(v sqlla.SetMapRawValue) importJobInsertOnDuplicateKeyUpdateSQL {
+	q.onDuplicateKeyUpdateMap["`host`"] = v
+	return q
+}
+
+func (q importJobInsertOnDuplicateKeyUpdateSQL) SameOnUpdateHost() importJobInsertOnDuplicateKeyUpdateSQL {
+	q.onDuplicateKeyUpdateMap["`host`"] = sqlla.SetMapRawValue("VALUES(`host`)")
+	return q
+}
+
+func (q importJobInsertOnDuplicateKeyUpdateSQL) ValueOnUpdatePath(v string) importJobInsertOnDuplicateKeyUpdateSQL {
+	q.onDuplicateKeyUpdateMap["`path`"] = v
+	return q
+}
+
+func (q importJobInsertOnDuplicateKeyUpdateSQL) RawValueOnUpdatePath(v sqlla.SetMapRawValue) importJobInsertOnDuplicateKeyUpdateSQL {
+	q.onDuplicateKeyUpdateMap["`path`"] = v
+	return q
+}
+
+func (q importJobInsertOnDuplicateKeyUpdateSQL) SameOnUpdatePath() importJobInsertOnDuplicateKeyUpdateSQL {
+	q.onDuplicateKeyUpdateMap["`path`"] = sqlla.SetMapRawValue("VALUES(`path`)")
+	return q
+}
+
+func (q importJobInsertOnDuplicateKeyUpdateSQL) ValueOnUpdateStatus(v string) importJobInsertOnDuplicateKeyUpdateSQL {
+	q.onDuplicateKeyUpdateMap["`status`"] = v
+	return q
+}
+
+func (q importJobInsertOnDuplicateKeyUpdateSQL) RawValueOnUpdateStatus(v sqlla.SetMapRawValue) importJobInsertOnDuplicateKeyUpdateSQL {
+	q.onDuplicateKeyUpdateMap["`status`"] = v
+	return q
+}
+
+func (q importJobInsertOnDuplicateKeyUpdateSQL) SameOnUpdateStatus() importJobInsertOnDuplicateKeyUpdateSQL {
+	q.onDuplicateKeyUpdateMap["`status`"] = sqlla.SetMapRawValue("VALUES(`status`)")
+	return q
+}
+
+func (q importJobInsertOnDuplicateKeyUpdateSQL) ValueOnUpdateRowsDone(v int64) importJobInsertOnDuplicateKeyUpdateSQL {
+	q.onDuplicateKeyUpdateMap["`rows_done`"] = v
+	return q
+}
+
+func (q importJobInsertOnDuplicateKeyUpdateSQL) RawValueOnUpdateRowsDone(v sqlla.SetMapRawValue) importJobInsertOnDuplicateKeyUpdateSQL {
+	q.onDuplicateKeyUpdateMap["`rows_done`"] = v
+	return q
+}
+
+func (q importJobInsertOnDuplicateKeyUpdateSQL) SameOnUpdateRowsDone() importJobInsertOnDuplicateKeyUpdateSQL {
+	q.onDuplicateKeyUpdateMap["`rows_done`"] = sqlla.SetMapRawValue("VALUES(`rows_done`)")
+	return q
+}
+
+func (q importJobInsertOnDuplicateKeyUpdateSQL) ValueOnUpdateInserted(v int64) importJobInsertOnDuplicateKeyUpdateSQL {
+	q.onDuplicateKeyUpdateMap["`inserted`"] = v
+	return q
+}
+
+func (q importJobInsertOnDuplicateKeyUpdateSQL) RawValueOnUpdateInserted(v sqlla.SetMapRawValue) importJobInsertOnDuplicateKeyUpdateSQL {
+	q.onDuplicateKeyUpdateMap["`inserted`"] = v
+	return q
+}
+
+func (q importJobInsertOnDuplicateKeyUpdateSQL) SameOnUpdateInserted() importJobInsertOnDuplicateKeyUpdateSQL {
+	q.onDuplicateKeyUpdateMap["`inserted`"] = sqlla.SetMapRawValue("VALUES(`inserted`)")
+	return q
+}
+
+func (q importJobInsertOnDuplicateKeyUpdateSQL) ValueOnUpdateUpdated(v int64) importJobInsertOnDuplicateKeyUpdateSQL {
+	q.onDuplicateKeyUpdateMap["`updated`"] = v
+	return q
+}
+
+func (q importJobInsertOnDuplicateKeyUpdateSQL) RawValueOnUpdateUpdated(v sqlla.SetMapRawValue) importJobInsertOnDuplicateKeyUpdateSQL {
+	q.onDuplicateKeyUpdateMap["`updated`"] = v
+	return q
+}
+
+func (q importJobInsertOnDuplicateKeyUpdateSQL) SameOnUpdateUpdated() importJobInsertOnDuplicateKeyUpdateSQL {
+	q.onDuplicateKeyUpdateMap["`updated`"] = sqlla.SetMapRawValue("VALUES(`updated`)")
+	return q
+}
+
+func (q importJobInsertOnDuplicateKeyUpdateSQL) ValueOnUpdateRejected(v int64) importJobInsertOnDuplicateKeyUpdateSQL {
+	q.onDuplicateKeyUpdateMap["`rejected`"] = v
+	return q
+}
+
+func (q importJobInsertOnDuplicateKeyUpdateSQL) RawValueOnUpdateRejected(v sqlla.SetMapRawValue) importJobInsertOnDuplicateKeyUpdateSQL {
+	q.onDuplicateKeyUpdateMap["`rejected`"] = v
+	return q
+}
+
+func (q importJobInsertOnDuplicateKeyUpdateSQL) SameOnUpdateRejected() importJobInsertOnDuplicateKeyUpdateSQL {
+	q.onDuplicateKeyUpdateMap["`rejected`"] = sqlla.SetMapRawValue("VALUES(`rejected`)")
+	return q
+}
+
+func (q importJobInsertOnDuplicateKeyUpdateSQL) ValueOnUpdateRejectedLines(v string) importJobInsertOnDuplicateKeyUpdateSQL {
+	q.onDuplicateKeyUpdateMap["`rejected_lines`"] = v
+	return q
+}
+
+func (q importJobInsertOnDuplicateKeyUpdateSQL) RawValueOnUpdateRejectedLines(v sqlla.SetMapRawValue) importJobInsertOnDuplicateKeyUpdateSQL {
+	q.onDuplicateKeyUpdateMap["`rejected_lines`"] = v
+	return q
+}
+
+func (q importJobInsertOnDuplicateKeyUpdateSQL) SameOnUpdateRejectedLines() importJobInsertOnDuplicateKeyUpdateSQL {
+	q.onDuplicateKeyUpdateMap["`rejected_lines`"] = sqlla.SetMapRawValue("VALUES(`rejected_lines`)")
+	return q
+}
+
+func (q importJobInsertOnDuplicateKeyUpdateSQL) ValueOnUpdateError(v string) importJobInsertOnDuplicateKeyUpdateSQL {
+	q.onDuplicateKeyUpdateMap["`error`"] = v
+	return q
+}
+
+func (q importJobInsertOnDuplicateKeyUpdateSQL) RawValueOnUpdateError(v sqlla.SetMapRawValue) importJobInsertOnDuplicateKeyUpdateSQL {
+	q.onDuplicateKeyUpdateMap["`error`"] = v
+	return q
+}
+
+func (q importJobInsertOnDuplicateKeyUpdateSQL) SameOnUpdateError() importJobInsertOnDuplicateKeyUpdateSQL {
+	q.onDuplicateKeyUpdateMap["`error`"] = sqlla.SetMapRawValue("VALUES(`error`)")
+	return q
+}
+
+func (q importJobInsertOnDuplicateKeyUpdateSQL) ValueOnUpdateCreatedAt(v time.Time) importJobInsertOnDuplicateKeyUpdateSQL {
+	q.onDuplicateKeyUpdateMap["`created_at`"] = v
+	return q
+}
+
+func (q importJobInsertOnDuplicateKeyUpdateSQL) RawValueOnUpdateCreatedAt(v sqlla.SetMapRawValue) importJobInsertOnDuplicateKeyUpdateSQL {
+	q.onDuplicateKeyUpdateMap["`created_at`"] = v
+	return q
+}
+
+func (q importJobInsertOnDuplicateKeyUpdateSQL) SameOnUpdateCreatedAt() importJobInsertOnDuplicateKeyUpdateSQL {
+	q.onDuplicateKeyUpdateMap["`created_at`"] = sqlla.SetMapRawValue("VALUES(`created_at`)")
+	return q
+}
+
+func (q importJobInsertOnDuplicateKeyUpdateSQL) ValueOnUpdateUpdatedAt(v time.Time) importJobInsertOnDuplicateKeyUpdateSQL {
+	q.onDuplicateKeyUpdateMap["`updated_at`"] = v
+	return q
+}
+
+func (q importJobInsertOnDuplicateKeyUpdateSQL) RawValueOnUpdateUpdatedAt(v sqlla.SetMapRawValue) importJobInsertOnDuplicateKeyUpdateSQL {
+	q.onDuplicateKeyUpdateMap["`updated_at`"] = v
+	return q
+}
+
+func (q importJobInsertOnDuplicateKeyUpdateSQL) SameOnUpdateUpdatedAt() importJobInsertOnDuplicateKeyUpdateSQL {
+	q.onDuplicateKeyUpdateMap["`updated_at`"] = sqlla.SetMapRawValue("VALUES(`updated_at`)")
+	return q
+}
+
+func (q importJobInsertOnDuplicateKeyUpdateSQL) ToSql() (string, []interface{}, error) {
+	var err error
+	var s interface{} = ImportJob{}
+	if t, ok := s.(importJobDefaultInsertOnDuplicateKeyUpdateHooker); ok {
+		q, err = t.DefaultInsertOnDuplicateKeyUpdateHook(q)
+		if err != nil {
+			return "", []interface{}{}, err
+		}
+	}
+
+	query, vs, err := q.insertSQL.importJobInsertSQLToSql()
+	if err != nil {
+		return "", []interface{}{}, err
+	}
+
+	os, ovs, err := q.onDuplicateKeyUpdateMap.ToUpdateSql()
+	if err != nil {
+		return "", []interface{}{}, err
+	}
+	query += " ON DUPLICATE KEY UPDATE" + os
+	vs = append(vs, ovs...)
+
+	return query + ";", vs, nil
+}
+
+func (q importJobInsertOnDuplicateKeyUpdateSQL) ExecContext(ctx context.Context, db sqlla.DB) (sql.Result, error) {
+	query, args, err := q.ToSql()
+	if err != nil {
+
+		return nil, err
+	}
+	result, err := db.ExecContext(ctx, query, args...)
+	return result, err
+}
+
+type importJobDefaultInsertOnDuplicateKeyUpdateHooker interface {
+	DefaultInsertOnDuplicateKeyUpdateHook(importJobInsertOnDuplicateKeyUpdateSQL) (importJobInsertOnDuplicateKeyUpdateSQL, error)
+}
+
+type importJobBulkInsertSQL struct {
+	insertSQLs []importJobInsertSQL
+}
+
+func (q importJobSQL) BulkInsert() *importJobBulkInsertSQL {
+	return &importJobBulkInsertSQL{
+		insertSQLs: []importJobInsertSQL{},
+	}
+}
+
+func (q *importJobBulkInsertSQL) Append(iqs ...importJobInsertSQL) {
+	q.insertSQLs = append(q.insertSQLs, iqs...)
+}
+
+func (q *importJobBulkInsertSQL) importJobInsertSQLToSql() (string, []interface{}, error) {
+	if len(q.insertSQLs) == 0 {
+		return "", []interface{}{}, fmt.Errorf("sqlla: This importJobBulkInsertSQL's InsertSQL was empty")
+	}
+	iqs := make([]importJobInsertSQL, len(q.insertSQLs))
+	copy(iqs, q.insertSQLs)
+
+	var s interface{} = ImportJob{}
+	if t, ok := s.(importJobDefaultInsertHooker); ok {
+		for i, iq := range iqs {
+			var err error
+			iq, err = t.DefaultInsertHook(iq)
+			if err != nil {
+				return "", []interface{}{}, err
+			}
+			iqs[i] = iq
+		}
+	}
+
+	sms := make(sqlla.SetMaps, 0, len(q.insertSQLs))
+	for _, iq := range q.insertSQLs {
+		sms = append(sms, iq.setMap)
+	}
+
+	query, vs, err := sms.ToInsertSql()
+	if err != nil {
+		return "", []interface{}{}, err
+	}
+
+	return "INSERT INTO `import_jobs` " + query, vs, nil
+}
+
+func (q *importJobBulkInsertSQL) ToSql() (string, []interface{}, error) {
+	query, vs, err := q.importJobInsertSQLToSql()
+	if err != nil {
+		return "", []interface{}{}, err
+	}
+	return query + ";", vs, nil
+}
+
+func (q *importJobBulkInsertSQL) OnDuplicateKeyUpdate() importJobInsertOnDuplicateKeyUpdateSQL {
+	return importJobInsertOnDuplicateKeyUpdateSQL{
+		insertSQL:               q,
+		onDuplicateKeyUpdateMap: sqlla.SetMap{},
+	}
+}
+
+func (q *importJobBulkInsertSQL) ExecContext(ctx context.Context, db sqlla.DB) (sql.Result, error) {
+	query, args, err := q.ToSql()
+	if err != nil {
+		return nil, err
+	}
+	result, err := db.ExecContext(ctx, query, args...)
+	return result, err
+}
+
+type importJobDeleteSQL struct {
+	importJobSQL
+}
+
+func (q importJobSQL) Delete() importJobDeleteSQL {
+	return importJobDeleteSQL{
+		q,
+	}
+}
+
+func (q importJobDeleteSQL) ID(v int64, exprs ...sqlla.Operator) importJobDeleteSQL {
+	var op sqlla.Operator
+	if len(exprs) == 0 {
+		op = sqlla.OpEqual
+	} else {
+		op = exprs[0]
+	}
+	where := sqlla.ExprInt64{Value: v, Op: op, Column: "`id`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q importJobDeleteSQL) IDIn(vs ...int64) importJobDeleteSQL {
+	where := sqlla.ExprMultiInt64{Values: vs, Op: sqlla.MakeInOperator(len(vs)), Column: "`id`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q importJobDeleteSQL) Target(v string, exprs ...sqlla.Operator) importJobDeleteSQL {
+	var op sqlla.Operator
+	if len(exprs) == 0 {
+		op = sqlla.OpEqual
+	} else {
+		op = exprs[0]
+	}
+	where := sqlla.ExprString{Value: v, Op: op, Column: "`target`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q importJobDeleteSQL) TargetIn(vs ...string) importJobDeleteSQL {
+	where := sqlla.ExprMultiString{Values: vs, Op: sqlla.MakeInOperator(len(vs)), Column: "`target`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q importJobDeleteSQL) Format(v string, exprs ...sqlla.Operator) importJobDeleteSQL {
+	var op sqlla.Operator
+	if len(exprs) == 0 {
+		op = sqlla.OpEqual
+	} else {
+		op = exprs[0]
+	}
+	where := sqlla.ExprString{Value: v, Op: op, Column: "`format`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q importJobDeleteSQL) FormatIn(vs ...string) importJobDeleteSQL {
+	where := sqlla.ExprMultiString{Values: vs, Op: sqlla.MakeInOperator(len(vs)), Column: "`format`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q importJobDeleteSQL) Mode(v string, exprs ...sqlla.Operator) importJobDeleteSQL {
+	var op sqlla.Operator
+	if len(exprs) == 0 {
+		op = sqlla.OpEqual
+	} else {
+		op = exprs[0]
+	}
+	where := sqlla.ExprString{Value: v, Op: op, Column: "`mode`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q importJobDeleteSQL) ModeIn(vs ...string) importJobDeleteSQL {
+	where := sqlla.ExprMultiString{Values: vs, Op: sqlla.MakeInOperator(len(vs)), Column: "`mode`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q importJobDeleteSQL) Header(v bool, exprs ...sqlla.Operator) importJobDeleteSQL {
+	var op sqlla.Operator
+	if len(exprs) == 0 {
+		op = sqlla.OpEqual
+	} else {
+		op = exprs[0]
+	}
+	where := sqlla.ExprBool{Value: v, Op: op, Column: "`header`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q importJobDeleteSQL) HeaderIn(vs ...bool) importJobDeleteSQL {
+	where := sqlla.ExprMultiBool{Values: vs, Op: sqlla.MakeInOperator(len(vs)), Column: "`header`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q importJobDeleteSQL) Host(v string, exprs ...sqlla.Operator) importJobDeleteSQL {
+	var op sqlla.Operator
+	if len(exprs) == 0 {
+		op = sqlla.OpEqual
+	} else {
+		op = exprs[0]
+	}
+	where := sqlla.ExprString{Value: v, Op: op, Column: "`host`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q importJobDeleteSQL) HostIn(vs ...string) importJobDeleteSQL {
+	where := sqlla.ExprMultiString{Values: vs, Op: sqlla.MakeInOperator(len(vs)), Column: "`host`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q importJobDeleteSQL) Path(v string, exprs ...sqlla.Operator) importJobDeleteSQL {
+	var op sqlla.Operator
+	if len(exprs) == 0 {
+		op = sqlla.OpEqual
+	} else {
+		op = exprs[0]
+	}
+	where := sqlla.ExprString{Value: v, Op: op, Column: "`path`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q importJobDeleteSQL) PathIn(vs ...string) importJobDeleteSQL {
+	where := sqlla.ExprMultiString{Values: vs, Op: sqlla.MakeInOperator(len(vs)), Column: "`path`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q importJobDeleteSQL) Status(v string, exprs ...sqlla.Operator) importJobDeleteSQL {
+	var op sqlla.Operator
+	if len(exprs) == 0 {
+		op = sqlla.OpEqual
+	} else {
+		op = exprs[0]
+	}
+	where := sqlla.ExprString{Value: v, Op: op, Column: "`status`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q importJobDeleteSQL) StatusIn(vs ...string) importJobDeleteSQL {
+	where := sqlla.ExprMultiString{Values: vs, Op: sqlla.MakeInOperator(len(vs)), Column: "`status`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q importJobDeleteSQL) RowsDone(v int64, exprs ...sqlla.Operator) importJobDeleteSQL {
+	var op sqlla.Operator
+	if len(exprs) == 0 {
+		op = sqlla.OpEqual
+	} else {
+		op = exprs[0]
+	}
+	where := sqlla.ExprInt64{Value: v, Op: op, Column: "`rows_done`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q importJobDeleteSQL) RowsDoneIn(vs ...int64) importJobDeleteSQL {
+	where := sqlla.ExprMultiInt64{Values: vs, Op: sqlla.MakeInOperator(len(vs)), Column: "`rows_done`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q importJobDeleteSQL) Inserted(v int64, exprs ...sqlla.Operator) importJobDeleteSQL {
+	var op sqlla.Operator
+	if len(exprs) == 0 {
+		op = sqlla.OpEqual
+	} else {
+		op = exprs[0]
+	}
+	where := sqlla.ExprInt64{Value: v, Op: op, Column: "`inserted`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q importJobDeleteSQL) InsertedIn(vs ...int64) importJobDeleteSQL {
+	where := sqlla.ExprMultiInt64{Values: vs, Op: sqlla.MakeInOperator(len(vs)), Column: "`inserted`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q importJobDeleteSQL) Updated(v int64, exprs ...sqlla.Operator) importJobDeleteSQL {
+	var op sqlla.Operator
+	if len(exprs) == 0 {
+		op = sqlla.OpEqual
+	} else {
+		op = exprs[0]
+	}
+	where := sqlla.ExprInt64{Value: v, Op: op, Column: "`updated`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q importJobDeleteSQL) UpdatedIn(vs ...int64) importJobDeleteSQL {
+	where := sqlla.ExprMultiInt64{Values: vs, Op: sqlla.MakeInOperator(len(vs)), Column: "`updated`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q importJobDeleteSQL) Rejected(v int64, exprs ...sqlla.Operator) importJobDeleteSQL {
+	var op sqlla.Operator
+	if len(exprs) == 0 {
+		op = sqlla.OpEqual
+	} else {
+		op = exprs[0]
+	}
+	where := sqlla.ExprInt64{Value: v, Op: op, Column: "`rejected`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q importJobDeleteSQL) RejectedIn(vs ...int64) importJobDeleteSQL {
+	where := sqlla.ExprMultiInt64{Values: vs, Op: sqlla.MakeInOperator(len(vs)), Column: "`rejected`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q importJobDeleteSQL) RejectedLines(v string, exprs ...sqlla.Operator) importJobDeleteSQL {
+	var op sqlla.Operator
+	if len(exprs) == 0 {
+		op = sqlla.OpEqual
+	} else {
+		op = exprs[0]
+	}
+	where := sqlla.ExprString{Value: v, Op: op, Column: "`rejected_lines`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q importJobDeleteSQL) RejectedLinesIn(vs ...string) importJobDeleteSQL {
+	where := sqlla.ExprMultiString{Values: vs, Op: sqlla.MakeInOperator(len(vs)), Column: "`rejected_lines`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q importJobDeleteSQL) Error(v string, exprs ...sqlla.Operator) importJobDeleteSQL {
+	var op sqlla.Operator
+	if len(exprs) == 0 {
+		op = sqlla.OpEqual
+	} else {
+		op = exprs[0]
+	}
+	where := sqlla.ExprString{Value: v, Op: op, Column: "`error`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q importJobDeleteSQL) ErrorIn(vs ...string) importJobDeleteSQL {
+	where := sqlla.ExprMultiString{Values: vs, Op: sqlla.MakeInOperator(len(vs)), Column: "`error`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q importJobDeleteSQL) CreatedAt(v time.Time, exprs ...sqlla.Operator) importJobDeleteSQL {
+	var op sqlla.Operator
+	if len(exprs) == 0 {
+		op = sqlla.OpEqual
+	} else {
+		op = exprs[0]
+	}
+	where := sqlla.ExprTime{Value: v, Op: op, Column: "`created_at`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q importJobDeleteSQL) CreatedAtIn(vs ...time.Time) importJobDeleteSQL {
+	where := sqlla.ExprMultiTime{Values: vs, Op: sqlla.MakeInOperator(len(vs)), Column: "`created_at`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q importJobDeleteSQL) UpdatedAt(v time.Time, exprs ...sqlla.Operator) importJobDeleteSQL {
+	var op sqlla.Operator
+	if len(exprs) == 0 {
+		op = sqlla.OpEqual
+	} else {
+		op = exprs[0]
+	}
+	where := sqlla.ExprTime{Value: v, Op: op, Column: "`updated_at`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q importJobDeleteSQL) UpdatedAtIn(vs ...time.Time) importJobDeleteSQL {
+	where := sqlla.ExprMultiTime{Values: vs, Op: sqlla.MakeInOperator(len(vs)), Column: "`updated_at`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q importJobDeleteSQL) ToSql() (string, []interface{}, error) {
+	wheres, vs, err := q.where.ToSql()
+	if err != nil {
+		return "", nil, err
+	}
+
+	query := "DELETE FROM import_jobs"
+	if wheres != "" {
+		query += " WHERE" + wheres
+	}
+
+	return query + ";", vs, nil
+}
+
+func (q importJobDeleteSQL) Exec(db sqlla.DB) (sql.Result, error) {
+	query, args, err := q.ToSql()
+	if err != nil {
+		return nil, err
+	}
+	return db.Exec(query, args...)
+}
+
+func (q importJobDeleteSQL) ExecContext(ctx context.Context, db sqlla.DB) (sql.Result, error) {
+	query, args, err := q.ToSql()
+	if err != nil {
+		return nil, err
+	}
+	return db.ExecContext(ctx, query, args...)
+}
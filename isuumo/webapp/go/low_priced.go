@@ -0,0 +1,324 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/labstack/echo/v4"
+)
+
+// MaxLowPricedLimit low_pricedのlimitの上限。キャッシュにはフィルタごとにこの件数まで持つ
+const MaxLowPricedLimit = 100
+
+// lowPricedFilter low_pricedのkind/featureクエリパラメータ。空なら絞り込まない
+type lowPricedFilter struct {
+	Kind    string
+	Feature string
+}
+
+func (f lowPricedFilter) matchFeatures(features string) bool {
+	return f.Feature == "" || slices.Contains(strings.Split(features, ","), f.Feature)
+}
+
+// lowPricedCache フィルタごとに安い順の先頭MaxLowPricedLimit件を持つ。
+// 購入や入稿のたびにDBを引き直さず、手元の一覧に差分を反映する
+type lowPricedCache[T any] struct {
+	mu      sync.Mutex
+	entries map[lowPricedFilter]*lowPricedEntry[T]
+	// gen 一覧を変更するたびに増やす。DBから読んでいる間に変更があった結果はキャッシュしない
+	gen   uint64
+	id    func(T) int64
+	less  func(a, b T) bool
+	match func(lowPricedFilter, T) bool
+}
+
+type lowPricedEntry[T any] struct {
+	rows []T
+	// complete 条件に合う行がすべてrowsに入っている
+	complete bool
+}
+
+func newLowPricedCache[T any](id func(T) int64, less func(a, b T) bool, match func(lowPricedFilter, T) bool) *lowPricedCache[T] {
+	return &lowPricedCache[T]{
+		entries: make(map[lowPricedFilter]*lowPricedEntry[T]),
+		id:      id,
+		less:    less,
+		match:   match,
+	}
+}
+
+var (
+	lowPricedChairs = newLowPricedCache(
+		func(c Chair) int64 { return c.ID },
+		func(a, b Chair) bool { return a.Price < b.Price || (a.Price == b.Price && a.ID < b.ID) },
+		func(f lowPricedFilter, c Chair) bool {
+			return c.visible() && (f.Kind == "" || c.Kind == f.Kind) && f.matchFeatures(c.Features)
+		},
+	)
+	lowPricedEstates = newLowPricedCache(
+		func(e Estate) int64 { return e.ID },
+		func(a, b Estate) bool { return a.Rent < b.Rent || (a.Rent == b.Rent && a.ID < b.ID) },
+		func(f lowPricedFilter, e Estate) bool { return e.visible() && f.matchFeatures(e.Features) },
+	)
+)
+
+// get キャッシュからlimit件を返す。足りなければfalseを返すので、loadで読み直す
+func (lc *lowPricedCache[T]) get(f lowPricedFilter, limit int) ([]T, bool) {
+	lc.mu.Lock()
+	defer lc.mu.Unlock()
+	e, ok := lc.entries[f]
+	if !ok || (len(e.rows) < limit && !e.complete) {
+		return nil, false
+	}
+	return slices.Clone(e.rows[:min(limit, len(e.rows))]), true
+}
+
+// load queryでDBから読んでキャッシュに入れ、limit件を返す
+func (lc *lowPricedCache[T]) load(f lowPricedFilter, limit int, query func() ([]T, error)) ([]T, error) {
+	lc.mu.Lock()
+	gen := lc.gen
+	lc.mu.Unlock()
+
+	rows, err := query()
+	if err != nil {
+		return nil, err
+	}
+
+	lc.mu.Lock()
+	if lc.gen == gen {
+		lc.entries[f] = &lowPricedEntry[T]{rows: rows, complete: len(rows) < MaxLowPricedLimit}
+	}
+	lc.mu.Unlock()
+	return slices.Clone(rows[:min(limit, len(rows))]), nil
+}
+
+// add 新しく入った行をそれぞれの一覧に差し込む。
+// 書き込みのコミットと並んで読み直した一覧には同じ行がもう入っていることがあるので、同じidの行は置き換える
+func (lc *lowPricedCache[T]) add(rows []T) {
+	lc.mu.Lock()
+	defer lc.mu.Unlock()
+	lc.gen++
+	for f, e := range lc.entries {
+		for _, row := range rows {
+			id := lc.id(row)
+			j := slices.IndexFunc(e.rows, func(r T) bool { return lc.id(r) == id })
+			if j >= 0 {
+				e.rows = slices.Delete(e.rows, j, j+1)
+			}
+			if !lc.match(f, row) {
+				continue
+			}
+			// 一覧に入っていない行より安いと言えるのは、末尾より安いときか全件持っているとき、もともと一覧にあったときだけ
+			if j < 0 && !e.complete && (len(e.rows) == 0 || !lc.less(row, e.rows[len(e.rows)-1])) {
+				continue
+			}
+			i, _ := slices.BinarySearchFunc(e.rows, row, func(a, b T) int {
+				if lc.less(a, b) {
+					return -1
+				}
+				if lc.less(b, a) {
+					return 1
+				}
+				return 0
+			})
+			e.rows = slices.Insert(e.rows, i, row)
+			if len(e.rows) > MaxLowPricedLimit {
+				e.rows = e.rows[:MaxLowPricedLimit]
+				e.complete = false
+			}
+		}
+	}
+}
+
+// remove 売り切れたり非公開になった行を一覧から外す。減った分は次にlimitに足りなくなったときに読み直す
+func (lc *lowPricedCache[T]) remove(ids ...int64) {
+	lc.mu.Lock()
+	defer lc.mu.Unlock()
+	lc.gen++
+	for _, e := range lc.entries {
+		e.rows = slices.DeleteFunc(e.rows, func(row T) bool {
+			return slices.Contains(ids, lc.id(row))
+		})
+	}
+}
+
+// clear 初期化などで行がまとめて入れ替わったときに全部捨てる
+func (lc *lowPricedCache[T]) clear() {
+	lc.mu.Lock()
+	defer lc.mu.Unlock()
+	lc.gen++
+	lc.entries = make(map[lowPricedFilter]*lowPricedEntry[T])
+}
+
+// lowPricedCollector 入稿中の行のうち、一覧に入りうるものだけをフィルタごとにMaxLowPricedLimit件まで残す。
+// 入稿の行をすべて手元に持たずに、コミットしてからaddで差し込むために使う
+type lowPricedCollector[T any] struct {
+	lc *lowPricedCache[T]
+	// filters 集め始めたときにあった一覧。あとから読まれた一覧には集めた行が足りないので捨てる
+	filters    map[lowPricedFilter]bool
+	candidates map[lowPricedFilter]*lowPricedCandidates[T]
+}
+
+type lowPricedCandidates[T any] struct {
+	// last 集め始めたときの一覧の末尾。completeでなければこれより安い行だけが一覧に入りうる
+	last     T
+	complete bool
+	rows     []T
+}
+
+func (lc *lowPricedCache[T]) collector() *lowPricedCollector[T] {
+	lc.mu.Lock()
+	defer lc.mu.Unlock()
+	col := &lowPricedCollector[T]{
+		lc:         lc,
+		filters:    make(map[lowPricedFilter]bool, len(lc.entries)),
+		candidates: make(map[lowPricedFilter]*lowPricedCandidates[T], len(lc.entries)),
+	}
+	for f, e := range lc.entries {
+		col.filters[f] = true
+		if !e.complete && len(e.rows) == 0 {
+			continue
+		}
+		c := &lowPricedCandidates[T]{complete: e.complete}
+		if len(e.rows) > 0 {
+			c.last = e.rows[len(e.rows)-1]
+		}
+		col.candidates[f] = c
+	}
+	return col
+}
+
+// collect 行がいずれかの一覧に入りうるなら残す
+func (col *lowPricedCollector[T]) collect(row T) {
+	lc := col.lc
+	for f, c := range col.candidates {
+		if !lc.match(f, row) || (!c.complete && !lc.less(row, c.last)) {
+			continue
+		}
+		i, _ := slices.BinarySearchFunc(c.rows, row, func(a, b T) int {
+			if lc.less(a, b) {
+				return -1
+			}
+			return 1
+		})
+		if i >= MaxLowPricedLimit {
+			continue
+		}
+		c.rows = slices.Insert(c.rows, i, row)
+		if len(c.rows) > MaxLowPricedLimit {
+			c.rows = c.rows[:MaxLowPricedLimit]
+		}
+	}
+}
+
+// watch nextで読んだ行をrecordでTに戻してcollectに渡す
+func (col *lowPricedCollector[T]) watch(next func() (uploadRow, error), record func(uploadRow) T) func() (uploadRow, error) {
+	return func() (uploadRow, error) {
+		r, err := next()
+		if err == nil {
+			col.collect(record(r))
+		}
+		return r, err
+	}
+}
+
+// addCollected コミットした入稿の行を一覧に差し込む。集め始めたあとに読まれた一覧は捨てて読み直させる
+func (lc *lowPricedCache[T]) addCollected(col *lowPricedCollector[T]) {
+	lc.mu.Lock()
+	lc.gen++
+	for f := range lc.entries {
+		if !col.filters[f] {
+			delete(lc.entries, f)
+		}
+	}
+	lc.mu.Unlock()
+
+	seen := make(map[int64]bool)
+	rows := make([]T, 0)
+	for _, c := range col.candidates {
+		for _, row := range c.rows {
+			if id := lc.id(row); !seen[id] {
+				seen[id] = true
+				rows = append(rows, row)
+			}
+		}
+	}
+	lc.add(rows)
+}
+
+// lowPricedParams limitとkind/featureを読む。kindは椅子のときだけ受け付ける
+func lowPricedParams(c echo.Context, kinds *ListCondition, features ListCondition) (int, lowPricedFilter, error) {
+	var f lowPricedFilter
+	limit, err := intRangeQueryParam(c, "limit", ErrCodeInvalidLimit, Limit, 1, MaxLowPricedLimit)
+	if err != nil {
+		return 0, f, err
+	}
+	if kinds != nil && c.QueryParam("kind") != "" {
+		if f.Kind, err = listValueParam(c, "kind", *kinds); err != nil {
+			return 0, f, err
+		}
+	}
+	if c.QueryParam("feature") != "" {
+		if f.Feature, err = listValueParam(c, "feature", features); err != nil {
+			return 0, f, err
+		}
+	}
+	return limit, f, nil
+}
+
+// listValueParam クエリパラメータが検索条件のfixtureのリストにある1つの値か確かめる
+func listValueParam(c echo.Context, name string, cond ListCondition) (string, error) {
+	v := c.QueryParam(name)
+	if !slices.Contains(cond.List, v) {
+		c.Logger().Infof("Invalid %s parameter : %v", name, v)
+		return "", newAPIError(http.StatusBadRequest, ErrCodeInvalidListValue, fmt.Sprintf("%s has an unknown value : %q", name, v))
+	}
+	return v, nil
+}
+
+func queryLowPricedChairs(ctx context.Context, db *sqlx.DB, f lowPricedFilter) ([]Chair, error) {
+	conditions := []string{visibleChairCondition}
+	params := make([]interface{}, 0, 3)
+	if f.Kind != "" {
+		conditions = append(conditions, "kind = ?")
+		params = append(params, f.Kind)
+	}
+	if f.Feature != "" {
+		conditions = append(conditions, "features_array @> ARRAY[?]")
+		params = append(params, f.Feature)
+	}
+	sort, _ := sortByName(chairSorts, "price")
+	orderBy, _ := sort.orderBy()
+	query := "SELECT * FROM chair WHERE " + strings.Join(conditions, " AND ") + orderBy + " LIMIT ?"
+	params = append(params, MaxLowPricedLimit)
+
+	chairs := make([]Chair, 0, MaxLowPricedLimit)
+	if err := db.SelectContext(ctx, &chairs, query, params...); err != nil {
+		return nil, err
+	}
+	return chairs, nil
+}
+
+func queryLowPricedEstates(ctx context.Context, db *sqlx.DB, f lowPricedFilter) ([]Estate, error) {
+	conditions := []string{visibleEstateCondition}
+	params := make([]interface{}, 0, 2)
+	if f.Feature != "" {
+		conditions = append(conditions, "features_array @> ARRAY[?]")
+		params = append(params, f.Feature)
+	}
+	sort, _ := sortByName(estateSorts, "rent")
+	orderBy, _ := sort.orderBy()
+	query := "SELECT * FROM estate WHERE " + strings.Join(conditions, " AND ") + orderBy + " LIMIT ?"
+	params = append(params, MaxLowPricedLimit)
+
+	estates := make([]Estate, 0, MaxLowPricedLimit)
+	if err := db.SelectContext(ctx, &estates, query, params...); err != nil {
+		return nil, err
+	}
+	return estates, nil
+}
@@ -0,0 +1,66 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+func newTestLowPricedChairs(rows []Chair) *lowPricedCache[Chair] {
+	lc := newLowPricedCache(lowPricedChairs.id, lowPricedChairs.less, lowPricedChairs.match)
+	lc.load(lowPricedFilter{}, MaxLowPricedLimit, func() ([]Chair, error) { return rows, nil })
+	return lc
+}
+
+func TestLowPricedCacheAdd(t *testing.T) {
+	chair := func(id, price int64) Chair {
+		return Chair{ID: id, Price: price, Stock: 1, Status: StatusActive}
+	}
+	tests := []struct {
+		name    string
+		cached  []Chair
+		add     []Chair
+		wantIDs []int64
+	}{
+		{
+			name:    "new cheaper row",
+			cached:  []Chair{chair(1, 100), chair(2, 200)},
+			add:     []Chair{chair(3, 150)},
+			wantIDs: []int64{1, 3, 2},
+		},
+		{
+			name:    "row already loaded",
+			cached:  []Chair{chair(1, 100), chair(2, 200)},
+			add:     []Chair{chair(2, 200)},
+			wantIDs: []int64{1, 2},
+		},
+		{
+			name:    "row already loaded with another price",
+			cached:  []Chair{chair(1, 100), chair(2, 200)},
+			add:     []Chair{chair(2, 50)},
+			wantIDs: []int64{2, 1},
+		},
+		{
+			name:    "row no longer visible",
+			cached:  []Chair{chair(1, 100), chair(2, 200)},
+			add:     []Chair{{ID: 1, Price: 100, Stock: 0, Status: StatusActive}},
+			wantIDs: []int64{2},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lc := newTestLowPricedChairs(tt.cached)
+			lc.add(tt.add)
+			rows, ok := lc.get(lowPricedFilter{}, MaxLowPricedLimit)
+			if !ok {
+				t.Fatal("get() = false, want cached rows")
+			}
+			ids := make([]int64, 0, len(rows))
+			for _, r := range rows {
+				ids = append(ids, r.ID)
+			}
+			if !reflect.DeepEqual(ids, tt.wantIDs) {
+				t.Errorf("get() ids = %v, want %v", ids, tt.wantIDs)
+			}
+		})
+	}
+}
@@ -3,16 +3,18 @@ package main
 import (
 	"context"
 	"database/sql"
-	"encoding/csv"
-	"encoding/json"
 	"fmt"
+	"io"
+	"math"
 	"net/http"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"reflect"
 	"slices"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/redis/go-redis/v9"
 
@@ -34,11 +36,9 @@ const (
 )
 
 var (
-	chairDB               *sqlx.DB
-	estateDB              *sqlx.DB
-	rdb                   *redis.Client
-	chairSearchCondition  ChairSearchCondition
-	estateSearchCondition EstateSearchCondition
+	chairDB  *sqlx.DB
+	estateDB *sqlx.DB
+	rdb      *redis.Client
 )
 
 type InitializeResponse struct {
@@ -60,6 +60,8 @@ type Chair struct {
 	Kind          string `db:"kind" json:"kind"`
 	Popularity    int64  `db:"popularity" json:"-"`
 	Stock         int64  `db:"stock" json:"-"`
+	Version       int64  `db:"version" json:"version"`
+	Status        string `db:"status" json:"status"`
 	FeaturesArray string `db:"features_array" json:"-"`
 	PriceRange    int64  `db:"price_range" json:"-"`
 	HeightRange   int64  `db:"height_range" json:"-"`
@@ -68,14 +70,69 @@ type Chair struct {
 }
 
 type ChairSearchResponse struct {
-	Count  int64   `json:"count"`
-	Chairs []Chair `json:"chairs"`
+	Count      *int64                  `json:"count,omitempty"`
+	Chairs     []Chair                 `json:"chairs"`
+	NextCursor string                  `json:"nextCursor,omitempty"`
+	Facets     map[string][]FacetCount `json:"facets,omitempty"`
 }
 
 type ChairListResponse struct {
 	Chairs []Chair `json:"chairs"`
 }
 
+// Order 椅子の購入履歴。chairと同じDBに置く
+//
+//sqlla:table orders
+type Order struct {
+	ID        int64     `db:"id" json:"id"`
+	ChairID   int64     `db:"chair_id" json:"chairId"`
+	Email     string    `db:"email" json:"email"`
+	Price     int64     `db:"price" json:"price"`
+	Quantity  int64     `db:"quantity" json:"quantity"`
+	CreatedAt time.Time `db:"created_at" json:"createdAt"`
+}
+
+type OrderListResponse struct {
+	Orders     []Order `json:"orders"`
+	NextCursor string  `json:"nextCursor,omitempty"`
+}
+
+// ChairStockAudit 管理APIで椅子の在庫を変えた記録。chairと同じDBに置く
+//
+//sqlla:table chair_stock_audits
+type ChairStockAudit struct {
+	ID          int64     `db:"id" json:"id"`
+	ChairID     int64     `db:"chair_id" json:"chairId"`
+	Operation   string    `db:"operation" json:"operation"`
+	Amount      int64     `db:"amount" json:"amount"`
+	StockBefore int64     `db:"stock_before" json:"stockBefore"`
+	StockAfter  int64     `db:"stock_after" json:"stockAfter"`
+	Reason      string    `db:"reason" json:"reason"`
+	CreatedAt   time.Time `db:"created_at" json:"createdAt"`
+}
+
+// ImportJob 非同期の入稿のジョブ。椅子の入稿もestateDBに置く
+//
+//sqlla:table import_jobs
+type ImportJob struct {
+	ID            int64     `db:"id" json:"id"`
+	Target        string    `db:"target" json:"target"`
+	Format        string    `db:"format" json:"format"`
+	Mode          string    `db:"mode" json:"mode"`
+	Header        bool      `db:"header" json:"header"`
+	Host          string    `db:"host" json:"-"`
+	Path          string    `db:"path" json:"-"`
+	Status        string    `db:"status" json:"status"`
+	RowsDone      int64     `db:"rows_done" json:"rowsDone"`
+	Inserted      int64     `db:"inserted" json:"inserted"`
+	Updated       int64     `db:"updated" json:"updated"`
+	Rejected      int64     `db:"rejected" json:"rejected"`
+	RejectedLines string    `db:"rejected_lines" json:"-"`
+	Error         string    `db:"error" json:"error,omitempty"`
+	CreatedAt     time.Time `db:"created_at" json:"createdAt"`
+	UpdatedAt     time.Time `db:"updated_at" json:"updatedAt"`
+}
+
 // Estate 物件
 //
 //sqlla:table estate
@@ -92,6 +149,8 @@ type Estate struct {
 	DoorWidth       int64   `db:"door_width" json:"doorWidth"`
 	Features        string  `db:"features" json:"features"`
 	Popularity      int64   `db:"popularity" json:"-"`
+	Version         int64   `db:"version" json:"version"`
+	Status          string  `db:"status" json:"status"`
 	FeaturesArray   string  `db:"features_array" json:"-"`
 	RentRange       int64   `db:"rent_range" json:"-"`
 	DoorHeightRange int64   `db:"door_height_range" json:"-"`
@@ -100,21 +159,44 @@ type Estate struct {
 
 // EstateSearchResponse estate/searchへのレスポンスの形式
 type EstateSearchResponse struct {
-	Count   int64    `json:"count"`
-	Estates []Estate `json:"estates"`
+	Count      *int64                  `json:"count,omitempty"`
+	Estates    []Estate                `json:"estates"`
+	NextCursor string                  `json:"nextCursor,omitempty"`
+	Facets     map[string][]FacetCount `json:"facets,omitempty"`
 }
 
 type EstateListResponse struct {
 	Estates []Estate `json:"estates"`
 }
 
+// DocumentRequest 物件への資料請求。estateと同じDBに置く
+//
+//sqlla:table document_requests
+type DocumentRequest struct {
+	ID        int64     `db:"id" json:"id"`
+	EstateID  int64     `db:"estate_id" json:"estateId"`
+	Email     string    `db:"email" json:"email"`
+	CreatedAt time.Time `db:"created_at" json:"createdAt"`
+}
+
+type DocumentRequestListResponse struct {
+	DocumentRequests []DocumentRequest `json:"documentRequests"`
+	NextCursor       string            `json:"nextCursor,omitempty"`
+}
+
+// EstateRequestDocumentRequest estate/req_docへのリクエストの形式
+type EstateRequestDocumentRequest struct {
+	Email string `json:"email" validate:"required,email"`
+}
+
 type Coordinate struct {
-	Latitude  float64 `json:"latitude"`
-	Longitude float64 `json:"longitude"`
+	Latitude  float64 `json:"latitude" validate:"gte=-90,lte=90"`
+	Longitude float64 `json:"longitude" validate:"gte=-180,lte=180"`
 }
 
+// Coordinates estate/nazotteへのリクエストの形式。ポリゴンとして扱うので3点以上必要
 type Coordinates struct {
-	Coordinates []Coordinate `json:"coordinates"`
+	Coordinates []Coordinate `json:"coordinates" validate:"required,min=3,max=1000,dive"`
 }
 
 type Range struct {
@@ -247,22 +329,6 @@ func (mc *PostgresEnv) ConnectDB() (*sqlx.DB, error) {
 	return sqlx.Open("mysql", dsn)
 }
 
-func init() {
-	jsonText, err := os.ReadFile("../fixture/chair_condition.json")
-	if err != nil {
-		fmt.Printf("%v\n", err)
-		os.Exit(1)
-	}
-	json.Unmarshal(jsonText, &chairSearchCondition)
-
-	jsonText, err = os.ReadFile("../fixture/estate_condition.json")
-	if err != nil {
-		fmt.Printf("%v\n", err)
-		os.Exit(1)
-	}
-	json.Unmarshal(jsonText, &estateSearchCondition)
-}
-
 func main() {
 	tp, _ := initTracer(context.Background())
 	defer func() {
@@ -281,6 +347,23 @@ func main() {
 	e.Use(middleware.Recover())
 	e.Use(otelecho.Middleware("isuumo"))
 
+	e.Validator = newRequestValidator()
+	e.HTTPErrorHandler = httpErrorHandler
+
+	conds, err := loadSearchConditions(fixtureDir())
+	if err != nil {
+		e.Logger.Fatalf("failed to load search conditions : %v", err)
+	}
+	searchConditions.Store(conds)
+
+	if insertBatchSize, err = insertBatchSizeFromEnv(); err != nil {
+		e.Logger.Fatalf("failed to load insert batch size : %v", err)
+	}
+	if documentRequestDedupeWindow, err = documentRequestDedupeWindowFromEnv(); err != nil {
+		e.Logger.Fatalf("failed to load document request dedupe window : %v", err)
+	}
+	adminToken = getEnv("ADMIN_TOKEN", "")
+
 	// Initialize
 	e.POST("/initialize", initialize)
 
@@ -291,6 +374,10 @@ func main() {
 	e.GET("/api/chair/low_priced", getLowPricedChair)
 	e.GET("/api/chair/search/condition", getChairSearchCondition)
 	e.POST("/api/chair/buy/:id", buyChair)
+	e.POST("/api/chair/buy", buyChairs)
+	e.PUT("/api/chair/:id", putChair, requireAdminToken)
+	e.PATCH("/api/chair/:id", patchChair, requireAdminToken)
+	e.DELETE("/api/chair/:id", deleteChair, requireAdminToken)
 
 	// Estate Handler
 	e.GET("/api/estate/:id", getEstateDetail)
@@ -300,9 +387,24 @@ func main() {
 	e.POST("/api/estate/req_doc/:id", postEstateRequestDocument)
 	e.POST("/api/estate/nazotte", searchEstateNazotte)
 	e.GET("/api/estate/search/condition", getEstateSearchCondition)
+	e.GET("/api/estate/:id/document_requests", getEstateDocumentRequests, requireAdminToken)
+	e.PUT("/api/estate/:id", putEstate, requireAdminToken)
+	e.PATCH("/api/estate/:id", patchEstate, requireAdminToken)
+	e.DELETE("/api/estate/:id", deleteEstate, requireAdminToken)
 	e.GET("/api/recommended_estate/:id", searchRecommendedEstateWithChair)
 
-	var err error
+	// Order Handler
+	e.GET("/api/orders", getOrders, requireAdminToken)
+
+	// Import Job Handler
+	e.GET("/api/import_jobs/:id", getImportJob)
+
+	// Admin Handler
+	e.POST("/admin/conditions/reload", postReloadConditions)
+	e.PATCH("/admin/chair/:id/stock", patchChairStock)
+	e.GET("/admin/export/chair", exportChairs)
+	e.GET("/admin/export/estate", exportEstates)
+
 	estateDB, err = GetDB(GetEnv("DB_HOSTNAME1", "192.168.0.12"))
 	if err != nil {
 		e.Logger.Fatalf("DB connection failed : %v", err)
@@ -317,6 +419,18 @@ func main() {
 	chairDB.SetMaxOpenConns(10)
 	defer chairDB.Close()
 
+	// `isuumo rebucket` で *_range 列をfixtureのRangesに合わせて作り直す
+	if len(os.Args) > 1 && os.Args[1] == "rebucket" {
+		if err := rebucketRanges(context.Background(), conds); err != nil {
+			e.Logger.Fatalf("rebucket failed : %v", err)
+		}
+		return
+	}
+	if err := verifyRangeBuckets(context.Background(), conds); err != nil {
+		e.Logger.Fatalf("range bucket columns do not match the search conditions, run `isuumo rebucket` : %v", err)
+	}
+	reloadConditionsOnSIGHUP(e)
+
 	rdb = redis.NewClient(&redis.Options{
 		Addr:     GetEnv("REDIS_HOSTNAME", "127.0.0.1") + ":6379",
 		Password: "", // no password set
@@ -326,6 +440,10 @@ func main() {
 		e.Logger.Fatalf("Redis connection failed : %v", err)
 	}
 
+	if err := startImportJobWorker(e.Logger); err != nil {
+		e.Logger.Fatalf("failed to start import job worker : %v", err)
+	}
+
 	// Start server
 	serverPort := fmt.Sprintf(":%v", getEnv("SERVER_PORT", "1323"))
 	e.Logger.Fatal(e.Start(serverPort))
@@ -351,8 +469,7 @@ func initialize(c echo.Context) error {
 		cmd := exec.Command("bash", "-c", cmdStr)
 		cmd.Env = append(cmd.Env, "PGPASSWORD="+pgConnectionData.Password)
 		if err := cmd.Run(); err != nil {
-			c.Logger().Errorf("Initialize script error : %v", err)
-			return c.NoContent(http.StatusInternalServerError)
+			return errInternal(fmt.Errorf("Initialize script error : %w", err))
 		}
 	}
 
@@ -361,6 +478,11 @@ func initialize(c echo.Context) error {
 		fmt.Printf("%v\n", err)
 		os.Exit(1)
 	}
+	lowPricedChairs.clear()
+	lowPricedEstates.clear()
+	if err := clearImportJobDir(); err != nil {
+		return errInternal(fmt.Errorf("failed to clear import job dir : %w", err))
+	}
 
 	return c.JSON(http.StatusOK, InitializeResponse{
 		Language: "go",
@@ -371,7 +493,7 @@ func getChairDetail(c echo.Context) error {
 	id, err := strconv.Atoi(c.Param("id"))
 	if err != nil {
 		c.Echo().Logger.Errorf("Request parameter \"id\" parse error : %v", err)
-		return c.NoContent(http.StatusBadRequest)
+		return newAPIError(http.StatusBadRequest, ErrCodeInvalidID, "id must be an integer")
 	}
 
 	ctx := c.Request().Context()
@@ -381,172 +503,311 @@ func getChairDetail(c echo.Context) error {
 	if err != nil {
 		if err == sql.ErrNoRows {
 			c.Echo().Logger.Infof("requested id's chair not found : %v", id)
-			return c.NoContent(http.StatusNotFound)
+			return newAPIError(http.StatusNotFound, ErrCodeChairNotFound, "chair not found")
 		}
-		c.Echo().Logger.Errorf("Failed to get the chair from id : %v", err)
-		return c.NoContent(http.StatusInternalServerError)
-	} else if chair.Stock <= 0 {
+		return errInternal(fmt.Errorf("Failed to get the chair from id : %w", err))
+	} else if chair.soldOut() {
 		c.Echo().Logger.Infof("requested id's chair is sold out : %v", id)
-		return c.NoContent(http.StatusNotFound)
+		return newAPIError(http.StatusNotFound, ErrCodeChairSoldOut, "chair is sold out")
+	} else if !chair.visible() {
+		c.Echo().Logger.Infof("requested id's chair is not active : %v", id)
+		return newAPIError(http.StatusNotFound, ErrCodeChairNotFound, "chair not found")
 	}
 
 	return c.JSON(http.StatusOK, chair)
 }
 
 func postChair(c echo.Context) error {
-	header, err := c.FormFile("chairs")
+	opts, err := uploadOptionsParam(c)
 	if err != nil {
-		c.Logger().Errorf("failed to get form file: %v", err)
-		return c.NoContent(http.StatusBadRequest)
+		return err
 	}
-	f, err := header.Open()
+	f, err := uploadBody(c, "chairs", opts.Format)
 	if err != nil {
-		c.Logger().Errorf("failed to open form file: %v", err)
-		return c.NoContent(http.StatusInternalServerError)
+		return err
 	}
 	defer f.Close()
-	records, err := csv.NewReader(f).ReadAll()
+
+	if opts.Async {
+		return enqueueImportJob(c, importTargetChair, f, opts)
+	}
+	res, err := importChairs(c.Request().Context(), c.Echo().Logger, f, opts, nil)
 	if err != nil {
-		c.Logger().Errorf("failed to read csv: %v", err)
-		return c.NoContent(http.StatusInternalServerError)
+		return err
 	}
+	return c.JSON(http.StatusCreated, res)
+}
 
-	ctx := c.Request().Context()
-	bi := NewChairSQL().BulkInsert()
-	for _, row := range records {
-		rm := RecordMapper{Record: row}
-		id := rm.NextInt()
-		name := rm.NextString()
-		description := rm.NextString()
-		thumbnail := rm.NextString()
-		price := rm.NextInt()
-		height := rm.NextInt()
-		width := rm.NextInt()
-		depth := rm.NextInt()
-		color := rm.NextString()
-		features := rm.NextString()
-		kind := rm.NextString()
-		popularity := rm.NextInt()
-		stock := rm.NextInt()
-		if err := rm.Err(); err != nil {
-			c.Logger().Errorf("failed to read record: %v", err)
-			return c.NoContent(http.StatusBadRequest)
-		}
-		bi.Append(
-			NewChairSQL().Insert().
-				ValueID(int64(id)).
-				ValueName(name).
-				ValueDescription(description).
-				ValueThumbnail(thumbnail).
-				ValuePrice(int64(price)).
-				ValueHeight(int64(height)).
-				ValueWidth(int64(width)).
-				ValueDepth(int64(depth)).
-				ValueColor(color).
-				ValueFeatures(features).
-				ValueKind(kind).
-				ValuePopularity(int64(popularity)).
-				ValueStock(int64(stock)),
-		)
+// importChairs 入稿を読んでchairに書く。postChairと非同期の入稿のジョブから呼ぶ
+func importChairs(ctx context.Context, logger echo.Logger, f io.Reader, opts uploadOptions, progress func(int64)) (*UploadResponse, error) {
+	var next func() (uploadRow, error)
+	var err error
+	switch {
+	case opts.Format != uploadFormatCSV:
+		next, err = jsonRowSource(f, opts.Format, chairUploadKeys, chairUpload.row)
+	case opts.Header:
+		next, err = csvHeaderRowSource(f, reflect.TypeOf(Chair{}), chairLoader)
+	default:
+		next = csvRowSource(newUploadCSVReader(f), func(rm *RecordMapper) uploadRow {
+			var u chairUpload
+			u.ID = int64(rm.NextInt())
+			u.Name = rm.NextString()
+			u.Description = rm.NextString()
+			u.Thumbnail = rm.NextString()
+			u.Price = int64(rm.NextInt())
+			u.Height = int64(rm.NextInt())
+			u.Width = int64(rm.NextInt())
+			u.Depth = int64(rm.NextInt())
+			u.Color = rm.NextString()
+			u.Features = rm.NextString()
+			u.Kind = rm.NextString()
+			u.Popularity = int64(rm.NextInt())
+			u.Stock = int64(rm.NextInt())
+			return u.row()
+		})
 	}
-	if _, err := bi.ExecContext(ctx, chairDB); err != nil {
-		c.Logger().Errorf("failed to insert chair: %v", err)
-		return c.NoContent(http.StatusInternalServerError)
+	if err != nil {
+		return nil, err
 	}
-	return c.NoContent(http.StatusCreated)
+
+	col := lowPricedChairs.collector()
+	res, err := chairLoader.load(ctx, chairDB, opts.Mode, col.watch(next, rowRecord[Chair](chairLoader)), progress)
+	if err != nil {
+		return nil, uploadError("chair", err)
+	}
+	if opts.Mode == uploadModeUpsert {
+		// 既存の行の値段が変わると一覧から外れる行が出るので、差し込むだけでは済まない
+		lowPricedChairs.clear()
+	} else {
+		lowPricedChairs.addCollected(col)
+	}
+	for _, id := range res.updatedIDs {
+		if err := purgeNginxCache(fmt.Sprintf("/api/chair/%d", id)); err != nil {
+			logger.Errorf("failed to purge nginx cache, id: %v : %v", id, err)
+		}
+	}
+	return res, nil
 }
 
-func searchChairs(c echo.Context) error {
+// chairSearchWhere 検索のクエリパラメータからWHERE句の条件を作る。exceptに指定したファセットの条件は含めない
+func chairSearchWhere(c echo.Context, sc ChairSearchCondition, except string) ([]string, []interface{}, error) {
 	conditions := make([]string, 0)
 	params := make([]interface{}, 0)
 
-	if c.QueryParam("priceRangeId") != "" {
+	if except != "price" && c.QueryParam("priceRangeId") != "" {
+		r, err := rangeParam(c, "priceRangeId", sc.Price)
+		if err != nil {
+			return nil, nil, err
+		}
 		conditions = append(conditions, "price_range = ?")
-		params = append(params, c.QueryParam("priceRangeId"))
+		params = append(params, r.ID)
 	}
 
-	if c.QueryParam("heightRangeId") != "" {
+	if except != "height" && c.QueryParam("heightRangeId") != "" {
+		r, err := rangeParam(c, "heightRangeId", sc.Height)
+		if err != nil {
+			return nil, nil, err
+		}
 		conditions = append(conditions, "height_range = ?")
-		params = append(params, c.QueryParam("heightRangeId"))
+		params = append(params, r.ID)
 	}
 
-	if c.QueryParam("widthRangeId") != "" {
+	if except != "width" && c.QueryParam("widthRangeId") != "" {
+		r, err := rangeParam(c, "widthRangeId", sc.Width)
+		if err != nil {
+			return nil, nil, err
+		}
 		conditions = append(conditions, "width_range = ?")
-		params = append(params, c.QueryParam("widthRangeId"))
+		params = append(params, r.ID)
 	}
 
-	if c.QueryParam("depthRangeId") != "" {
+	if except != "depth" && c.QueryParam("depthRangeId") != "" {
+		r, err := rangeParam(c, "depthRangeId", sc.Depth)
+		if err != nil {
+			return nil, nil, err
+		}
 		conditions = append(conditions, "depth_range = ?")
-		params = append(params, c.QueryParam("depthRangeId"))
+		params = append(params, r.ID)
 	}
 
-	if c.QueryParam("kind") != "" {
-		conditions = append(conditions, "kind = ?")
-		params = append(params, c.QueryParam("kind"))
+	if except != "price" && (c.QueryParam("priceMin") != "" || c.QueryParam("priceMax") != "") {
+		cs, ps, err := rangeBoundsParam(c, "price", "price", sc.Price)
+		if err != nil {
+			return nil, nil, err
+		}
+		conditions = append(conditions, cs...)
+		params = append(params, ps...)
 	}
 
-	if c.QueryParam("color") != "" {
-		conditions = append(conditions, "color = ?")
-		params = append(params, c.QueryParam("color"))
+	if except != "width" && (c.QueryParam("widthMin") != "" || c.QueryParam("widthMax") != "") {
+		cs, ps, err := rangeBoundsParam(c, "width", "width", sc.Width)
+		if err != nil {
+			return nil, nil, err
+		}
+		conditions = append(conditions, cs...)
+		params = append(params, ps...)
 	}
 
-	if c.QueryParam("features") != "" {
-		ss := strings.Split(c.QueryParam("features"), ",")
+	if except != "kind" && c.QueryParam("kind") != "" {
+		kinds, err := listValuesParam(c, "kind", sc.Kind)
+		if err != nil {
+			return nil, nil, err
+		}
+		conditions = append(conditions, inCondition("kind", len(kinds)))
+		for _, v := range kinds {
+			params = append(params, v)
+		}
+	}
+
+	if except != "color" && c.QueryParam("color") != "" {
+		colors, err := listValuesParam(c, "color", sc.Color)
+		if err != nil {
+			return nil, nil, err
+		}
+		conditions = append(conditions, inCondition("color", len(colors)))
+		for _, v := range colors {
+			params = append(params, v)
+		}
+	}
+
+	if except != "features" && c.QueryParam("features") != "" {
+		ss, err := listValuesParam(c, "features", sc.Feature)
+		if err != nil {
+			return nil, nil, err
+		}
+		op, err := featuresModeParam(c)
+		if err != nil {
+			return nil, nil, err
+		}
 		if len(ss) > 0 {
 			for _, s := range ss {
 				params = append(params, s)
 			}
 			conditions = append(
 				conditions,
-				fmt.Sprintf("features_array @> ARRAY[?%s]",
-					strings.Repeat(",?", len(ss)-1)),
+				fmt.Sprintf("features_array %s ARRAY[?%s]",
+					op, strings.Repeat(",?", len(ss)-1)),
 			)
 		}
 	}
 
+	q, err := textSearchParam(c)
+	if err != nil {
+		return nil, nil, err
+	}
+	if q != "" {
+		cs, ps := textSearchConditions(q)
+		conditions = append(conditions, cs...)
+		params = append(params, ps...)
+	}
+
+	return conditions, params, nil
+}
+
+func searchChairs(c echo.Context) error {
+	sc := currentSearchConditions().Chair
+	conditions, params, err := chairSearchWhere(c, sc, "")
+	if err != nil {
+		return err
+	}
+
+	q, err := textSearchParam(c)
+	if err != nil {
+		return err
+	}
+
 	if len(conditions) == 0 {
 		c.Echo().Logger.Infof("Search condition not found")
-		return c.NoContent(http.StatusBadRequest)
+		return newAPIError(http.StatusBadRequest, ErrCodeSearchConditionNotFound, "at least one search condition is required")
 	}
 
-	conditions = append(conditions, "stock > 0")
+	conditions = append(conditions, visibleChairCondition)
 
-	page, err := strconv.Atoi(c.QueryParam("page"))
+	sort, err := sortParam(c, chairSorts, q)
 	if err != nil {
-		c.Logger().Infof("Invalid format page parameter : %v", err)
-		return c.NoContent(http.StatusBadRequest)
+		return err
 	}
 
-	perPage, err := strconv.Atoi(c.QueryParam("perPage"))
+	cursor, err := sort.cursorParam(c)
 	if err != nil {
-		c.Logger().Infof("Invalid format perPage parameter : %v", err)
-		return c.NoContent(http.StatusBadRequest)
+		return err
+	}
+
+	withCount, err := withCountParam(c)
+	if err != nil {
+		return err
+	}
+
+	withFacets, err := boolQueryParam(c, "facets", false)
+	if err != nil {
+		return err
+	}
+
+	pagination, err := paginationParam(c)
+	if err != nil {
+		return err
+	}
+	perPage := pagination.PerPage
+	// cursorがあればそこから続きを返すので、古いクライアント向けのpageは使わない
+	offset := pagination.Offset()
+	if cursor != nil {
+		offset = 0
 	}
 
 	searchQuery := "SELECT * FROM chair WHERE "
 	countQuery := "SELECT COUNT(*) FROM chair WHERE "
 	searchCondition := strings.Join(conditions, " AND ")
-	limitOffset := " ORDER BY popularity DESC, id ASC LIMIT ? OFFSET ?"
+	orderBy, orderParams := sort.orderBy()
+	limitOffset := orderBy + " LIMIT ? OFFSET ?"
 
 	ctx := c.Request().Context()
 	var res ChairSearchResponse
-	err = chairDB.GetContext(ctx, &res.Count, countQuery+searchCondition, params...)
-	if err != nil {
-		c.Logger().Errorf("searchChairs DB execution error : %v", err)
-		return c.NoContent(http.StatusInternalServerError)
+	if withCount {
+		var count int64
+		err = chairDB.GetContext(ctx, &count, countQuery+searchCondition, params...)
+		if err != nil {
+			return errInternal(fmt.Errorf("searchChairs DB execution error : %w", err))
+		}
+		res.Count = &count
 	}
 
+	if withFacets {
+		res.Facets = make(map[string][]FacetCount)
+		for _, f := range chairFacets(sc) {
+			// ファセットごとに、そのファセット自身の条件だけを外して数える
+			fcs, fps, err := chairSearchWhere(c, sc, f.name)
+			if err != nil {
+				return err
+			}
+			fcs = append(fcs, visibleChairCondition)
+			counts, err := f.count(ctx, chairDB, "chair", fcs, fps)
+			if err != nil {
+				return errInternal(fmt.Errorf("searchChairs facet DB execution error : %w", err))
+			}
+			res.Facets[f.name] = counts
+		}
+	}
+
+	if cursor != nil {
+		cond, cursorParams := sort.cursorCondition(cursor)
+		searchCondition += " AND " + cond
+		params = append(params, cursorParams...)
+	}
+
+	// 次のページがあるかを知るために1件多く取る
 	chairs := []Chair{}
-	params = append(params, perPage, page*perPage)
+	params = append(params, orderParams...)
+	params = append(params, perPage+1, offset)
 	err = chairDB.SelectContext(ctx, &chairs, searchQuery+searchCondition+limitOffset, params...)
 	if err != nil {
-		if err == sql.ErrNoRows {
-			return c.JSON(http.StatusOK, ChairSearchResponse{Count: 0, Chairs: []Chair{}})
-		}
-		c.Logger().Errorf("searchChairs DB execution error : %v", err)
-		return c.NoContent(http.StatusInternalServerError)
+		return errInternal(fmt.Errorf("searchChairs DB execution error : %w", err))
 	}
 
+	if len(chairs) > perPage {
+		chairs = chairs[:perPage]
+		last := chairs[len(chairs)-1]
+		res.NextCursor = sort.nextCursor(last, last.ID)
+	}
 	res.Chairs = chairs
 
 	return c.JSON(http.StatusOK, res)
@@ -554,65 +815,192 @@ func searchChairs(c echo.Context) error {
 
 const soldOutChairKey = "sold_out_chair"
 
+// BuyChairRequest chair/buyへのリクエストの形式
+type BuyChairRequest struct {
+	Email string `json:"email" validate:"required,email"`
+}
+
 func buyChair(c echo.Context) error {
-	m := echo.Map{}
-	if err := c.Bind(&m); err != nil {
+	var req BuyChairRequest
+	if err := bindAndValidate(c, &req); err != nil {
 		c.Echo().Logger.Infof("post buy chair failed : %v", err)
-		return c.NoContent(http.StatusInternalServerError)
-	}
-
-	_, ok := m["email"].(string)
-	if !ok {
-		c.Echo().Logger.Info("post buy chair failed : email not found in request body")
-		return c.NoContent(http.StatusBadRequest)
+		return newValidationError(err)
 	}
 
 	id, err := strconv.Atoi(c.Param("id"))
 	if err != nil {
 		c.Echo().Logger.Infof("post buy chair failed : %v", err)
-		return c.NoContent(http.StatusBadRequest)
+		return newAPIError(http.StatusBadRequest, ErrCodeInvalidID, "id must be an integer")
 	}
 
 	ctx := c.Request().Context()
+	tx, err := chairDB.BeginTxx(ctx, nil)
+	if err != nil {
+		return errInternal(fmt.Errorf("failed to begin tx : %w", err))
+	}
+	defer tx.Rollback()
 
-	var stock int64
-	row := chairDB.QueryRowContext(ctx, "UPDATE chair SET stock = stock - 1 WHERE id = ? AND stock > 0 RETURNING stock", id)
-	if err := row.Scan(&stock); err != nil {
+	var stock, price int64
+	row := tx.QueryRowContext(ctx, "UPDATE chair SET stock = stock - 1 WHERE id = ? AND "+visibleChairCondition+" RETURNING stock, price", id)
+	if err := row.Scan(&stock, &price); err != nil {
 		if err == sql.ErrNoRows {
 			c.Echo().Logger.Infof("buyChair chair id \"%v\" not found", id)
-			return c.NoContent(http.StatusNotFound)
+			return chairUnavailableError(ctx, tx, int64(id), 1)
 		}
-		c.Echo().Logger.Errorf("chair stock update failed : %v", err)
-		return c.NoContent(http.StatusInternalServerError)
+		return errInternal(fmt.Errorf("chair stock update failed : %w", err))
+	}
+
+	if err := insertOrder(ctx, tx, int64(id), req.Email, price, 1); err != nil {
+		return errInternal(fmt.Errorf("failed to insert order : %w", err))
 	}
 
 	// 残り1つを購入したことになるので在庫切れリストに追加する
 	if stock == 0 {
-		if err := rdb.SAdd(c.Request().Context(), soldOutChairKey, id).Err(); err != nil {
-			c.Echo().Logger.Errorf("failed to insert sold_out_chair to redis, id: %v", id)
-			return c.NoContent(http.StatusInsufficientStorage)
+		if err := rdb.SAdd(ctx, soldOutChairKey, id).Err(); err != nil {
+			return &APIError{
+				Status:   http.StatusInsufficientStorage,
+				Code:     ErrCodeSoldOutUpdateFailed,
+				Message:  "failed to update sold out chairs",
+				Internal: fmt.Errorf("failed to insert sold_out_chair to redis, id: %v : %w", id, err),
+			}
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		if stock == 0 {
+			if err := rdb.SRem(context.Background(), soldOutChairKey, id).Err(); err != nil {
+				c.Echo().Logger.Errorf("failed to remove sold_out_chair from redis, id: %v", id)
+			}
+		}
+		return errInternal(fmt.Errorf("failed to commit tx : %w", err))
+	}
+	if stock == 0 {
+		lowPricedChairs.remove(int64(id))
+	}
+
+	return c.NoContent(http.StatusOK)
+}
+
+type BuyChairItem struct {
+	ID int64 `json:"id" validate:"gt=0"`
+	// Quantity 在庫の上限と同じ。同じ椅子の行をまとめて足しても溢れないように抑える
+	Quantity int64 `json:"quantity" validate:"gt=0,lte=1000000"`
+}
+
+type BuyChairsRequest struct {
+	Email string         `json:"email" validate:"required,email"`
+	Items []BuyChairItem `json:"items" validate:"required,min=1,max=100,dive"`
+}
+
+// buyChairs 複数の椅子をまとめて購入する。1つでも在庫が足りなければ何も減らさない
+func buyChairs(c echo.Context) error {
+	var req BuyChairsRequest
+	if err := bindAndValidate(c, &req); err != nil {
+		c.Echo().Logger.Infof("post buy chairs failed : %v", err)
+		return newValidationError(err)
+	}
+
+	// 同じ椅子が複数行に分かれていてもまとめて確保する
+	quantities := make(map[int64]int64, len(req.Items))
+	for _, item := range req.Items {
+		quantities[item.ID] += item.Quantity
+	}
+	// デッドロックを避けるため常にid順にロックを取る
+	ids := make([]int64, 0, len(quantities))
+	for id := range quantities {
+		ids = append(ids, id)
+	}
+	slices.Sort(ids)
+
+	ctx := c.Request().Context()
+	tx, err := chairDB.BeginTxx(ctx, nil)
+	if err != nil {
+		return errInternal(fmt.Errorf("failed to begin tx : %w", err))
+	}
+	defer tx.Rollback()
+
+	soldOut := make([]interface{}, 0, len(ids))
+	for _, id := range ids {
+		var stock, price int64
+		row := tx.QueryRowContext(ctx, "UPDATE chair SET stock = stock - ? WHERE id = ? AND stock >= ? AND "+visibleChairCondition+" RETURNING stock, price", quantities[id], id, quantities[id])
+		if err := row.Scan(&stock, &price); err != nil {
+			if err == sql.ErrNoRows {
+				c.Echo().Logger.Infof("buyChairs chair id \"%v\" not found or out of stock", id)
+				return chairUnavailableError(ctx, tx, id, quantities[id])
+			}
+			return errInternal(fmt.Errorf("chair stock update failed : %w", err))
+		}
+		if err := insertOrder(ctx, tx, id, req.Email, price, quantities[id]); err != nil {
+			return errInternal(fmt.Errorf("failed to insert order : %w", err))
+		}
+		if stock == 0 {
+			soldOut = append(soldOut, id)
+		}
+	}
+
+	// 在庫切れリストへの追加に失敗したら在庫も戻す
+	if len(soldOut) > 0 {
+		if err := rdb.SAdd(ctx, soldOutChairKey, soldOut...).Err(); err != nil {
+			return &APIError{
+				Status:   http.StatusInsufficientStorage,
+				Code:     ErrCodeSoldOutUpdateFailed,
+				Message:  "failed to update sold out chairs",
+				Internal: fmt.Errorf("failed to insert sold_out_chair to redis, ids: %v : %w", soldOut, err),
+			}
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		if len(soldOut) > 0 {
+			if err := rdb.SRem(context.Background(), soldOutChairKey, soldOut...).Err(); err != nil {
+				c.Echo().Logger.Errorf("failed to remove sold_out_chair from redis, ids: %v", soldOut)
+			}
 		}
+		return errInternal(fmt.Errorf("failed to commit tx : %w", err))
+	}
+	for _, id := range soldOut {
+		lowPricedChairs.remove(id.(int64))
 	}
 
 	return c.NoContent(http.StatusOK)
 }
 
+// chairUnavailableError 在庫を確保できなかった椅子について、存在しない(非公開を含む)のか売り切れなのか在庫が足りないのかを返す
+func chairUnavailableError(ctx context.Context, tx *sqlx.Tx, id int64, quantity int64) error {
+	var chair Chair
+	err := tx.GetContext(ctx, &chair, "SELECT * FROM chair WHERE id = ?", id)
+	if err != nil && err != sql.ErrNoRows {
+		return errInternal(fmt.Errorf("failed to get chair stock : %w", err))
+	}
+	if chair.soldOut() {
+		return newAPIError(http.StatusNotFound, ErrCodeChairSoldOut, fmt.Sprintf("chair %d is sold out", id))
+	}
+	if err == sql.ErrNoRows || !chair.visible() {
+		return newAPIError(http.StatusNotFound, ErrCodeChairNotFound, fmt.Sprintf("chair %d not found", id))
+	}
+	return newAPIError(http.StatusConflict, ErrCodeInsufficientStock, fmt.Sprintf("chair %d has only %d in stock but %d requested", id, chair.Stock, quantity))
+}
+
 func getChairSearchCondition(c echo.Context) error {
-	return c.JSON(http.StatusOK, chairSearchCondition)
+	return c.JSON(http.StatusOK, currentSearchConditions().Chair)
 }
 
 func getLowPricedChair(c echo.Context) error {
-	ctx := c.Request().Context()
-	var chairs []Chair
-	query := `SELECT * FROM chair WHERE stock > 0 ORDER BY price ASC, id ASC LIMIT ?`
-	err := chairDB.SelectContext(ctx, &chairs, query, Limit)
+	sc := currentSearchConditions().Chair
+	limit, f, err := lowPricedParams(c, &sc.Kind, sc.Feature)
 	if err != nil {
-		if err == sql.ErrNoRows {
-			c.Logger().Error("getLowPricedChair not found")
-			return c.JSON(http.StatusOK, ChairListResponse{[]Chair{}})
+		return err
+	}
+
+	ctx := c.Request().Context()
+	chairs, ok := lowPricedChairs.get(f, limit)
+	if !ok {
+		chairs, err = lowPricedChairs.load(f, limit, func() ([]Chair, error) {
+			return queryLowPricedChairs(ctx, chairDB, f)
+		})
+		if err != nil {
+			return errInternal(fmt.Errorf("getLowPricedChair DB execution error : %w", err))
 		}
-		c.Logger().Errorf("getLowPricedChair DB execution error : %v", err)
-		return c.NoContent(http.StatusInternalServerError)
 	}
 
 	return c.JSON(http.StatusOK, ChairListResponse{Chairs: chairs})
@@ -622,24 +1010,121 @@ func getEstateDetail(c echo.Context) error {
 	id, err := strconv.Atoi(c.Param("id"))
 	if err != nil {
 		c.Echo().Logger.Infof("Request parameter \"id\" parse error : %v", err)
-		return c.NoContent(http.StatusBadRequest)
+		return newAPIError(http.StatusBadRequest, ErrCodeInvalidID, "id must be an integer")
 	}
 
 	ctx := c.Request().Context()
 	var estate Estate
-	err = estateDB.GetContext(ctx, &estate, "SELECT * FROM estate WHERE id = ?", id)
+	err = estateDB.GetContext(ctx, &estate, "SELECT * FROM estate WHERE id = ? AND "+visibleEstateCondition, id)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			c.Echo().Logger.Infof("getEstateDetail estate id %v not found", id)
-			return c.NoContent(http.StatusNotFound)
+			return newAPIError(http.StatusNotFound, ErrCodeEstateNotFound, "estate not found")
 		}
-		c.Echo().Logger.Errorf("Database Execution error : %v", err)
-		return c.NoContent(http.StatusInternalServerError)
+		return errInternal(fmt.Errorf("Database Execution error : %w", err))
 	}
 
 	return c.JSON(http.StatusOK, estate)
 }
 
+// rangeParam クエリパラメータのrange idを検索条件のfixtureにある範囲として読む
+func rangeParam(c echo.Context, name string, cond RangeCondition) (*Range, error) {
+	r, err := getRange(cond, c.QueryParam(name))
+	if err != nil {
+		c.Logger().Infof("Invalid %s parameter : %v", name, err)
+		return nil, newAPIError(http.StatusBadRequest, ErrCodeInvalidRangeID, fmt.Sprintf("%s must be one of the range ids in the search condition", name))
+	}
+	return r, nil
+}
+
+// rangeBoundsParam <name>Min/<name>Maxを読んで絞り込み条件を返す。minとmaxはどちらも含む。
+// インデックスを使えるように *_range 列で重なるバケットに絞ってから、バケットの途中で切れる端だけ元の列で絞る
+func rangeBoundsParam(c echo.Context, name, column string, cond RangeCondition) ([]string, []interface{}, error) {
+	min, err := intRangeQueryParam(c, name+"Min", ErrCodeInvalidRangeBound, -1, 0, math.MaxInt32)
+	if err != nil {
+		return nil, nil, err
+	}
+	max, err := intRangeQueryParam(c, name+"Max", ErrCodeInvalidRangeBound, -1, 0, math.MaxInt32)
+	if err != nil {
+		return nil, nil, err
+	}
+	if min == -1 && max == -1 {
+		return nil, nil, nil
+	}
+	if min != -1 && max != -1 && min > max {
+		c.Logger().Infof("Invalid %sMin and %sMax parameters : %d > %d", name, name, min, max)
+		return nil, nil, newAPIError(http.StatusBadRequest, ErrCodeInvalidRangeBound, fmt.Sprintf("%sMin must not be greater than %sMax", name, name))
+	}
+
+	lo, hi := -1, -1
+	for i, r := range cond.Ranges {
+		if max != -1 && r.Min != -1 && int64(max) < r.Min {
+			continue
+		}
+		if min != -1 && r.Max != -1 && r.Max <= int64(min) {
+			continue
+		}
+		if lo == -1 {
+			lo = i
+		}
+		hi = i
+	}
+
+	conditions := make([]string, 0, 3)
+	params := make([]interface{}, 0, 3)
+	if lo != -1 && lo == hi {
+		// バケットが1つならORDER BYまでインデックスで済む
+		conditions = append(conditions, column+"_range = ?")
+		params = append(params, cond.Ranges[lo].ID)
+	} else if lo != -1 && (lo != 0 || hi != len(cond.Ranges)-1) {
+		conditions = append(conditions, column+"_range BETWEEN ? AND ?")
+		params = append(params, cond.Ranges[lo].ID, cond.Ranges[hi].ID)
+	}
+	if min != -1 && (lo == -1 || cond.Ranges[lo].Min != int64(min)) {
+		conditions = append(conditions, column+" >= ?")
+		params = append(params, min)
+	}
+	if max != -1 && (hi == -1 || cond.Ranges[hi].Max != int64(max)+1) {
+		conditions = append(conditions, column+" <= ?")
+		params = append(params, max)
+	}
+	return conditions, params, nil
+}
+
+// listValuesParam カンマ区切りのクエリパラメータの値がすべて検索条件のfixtureのリストにあるか確かめる
+func listValuesParam(c echo.Context, name string, cond ListCondition) ([]string, error) {
+	vs := strings.Split(c.QueryParam(name), ",")
+	for _, v := range vs {
+		if !slices.Contains(cond.List, v) {
+			c.Logger().Infof("Invalid %s parameter : %v", name, v)
+			return nil, newAPIError(http.StatusBadRequest, ErrCodeInvalidListValue, fmt.Sprintf("%s has an unknown value : %q", name, v))
+		}
+	}
+	return vs, nil
+}
+
+// inCondition 値が1つなら =、複数なら IN で絞り込む条件を返す
+func inCondition(column string, n int) string {
+	if n == 1 {
+		return column + " = ?"
+	}
+	return fmt.Sprintf("%s IN (?%s)", column, strings.Repeat(",?", n-1))
+}
+
+// featuresModeParam featuresMode=anyならいずれかの特徴を持つもの、allならすべて持つものに絞る。
+// どちらもfeatures_arrayのGINインデックスが使える演算子を返す
+func featuresModeParam(c echo.Context) (string, error) {
+	switch mode := c.QueryParam("featuresMode"); mode {
+	case "", "all":
+		return "@>", nil
+	case "any":
+		return "&&", nil
+	default:
+		c.Logger().Infof("Invalid featuresMode parameter : %v", mode)
+		return "", newAPIError(http.StatusBadRequest, ErrCodeInvalidListValue, fmt.Sprintf("featuresMode must be any or all : %q", mode))
+	}
+}
+
 func getRange(cond RangeCondition, rangeID string) (*Range, error) {
 	RangeIndex, err := strconv.Atoi(rangeID)
 	if err != nil {
@@ -654,160 +1139,286 @@ func getRange(cond RangeCondition, rangeID string) (*Range, error) {
 }
 
 func postEstate(c echo.Context) error {
-	header, err := c.FormFile("estates")
+	opts, err := uploadOptionsParam(c)
 	if err != nil {
-		c.Logger().Errorf("failed to get form file: %v", err)
-		return c.NoContent(http.StatusBadRequest)
+		return err
 	}
-	f, err := header.Open()
+	f, err := uploadBody(c, "estates", opts.Format)
 	if err != nil {
-		c.Logger().Errorf("failed to open form file: %v", err)
-		return c.NoContent(http.StatusInternalServerError)
+		return err
 	}
 	defer f.Close()
-	records, err := csv.NewReader(f).ReadAll()
+
+	if opts.Async {
+		return enqueueImportJob(c, importTargetEstate, f, opts)
+	}
+	res, err := importEstates(c.Request().Context(), c.Echo().Logger, f, opts, nil)
 	if err != nil {
-		c.Logger().Errorf("failed to read csv: %v", err)
-		return c.NoContent(http.StatusInternalServerError)
+		return err
 	}
+	return c.JSON(http.StatusCreated, res)
+}
 
-	ctx := c.Request().Context()
-	bi := NewEstateSQL().BulkInsert()
-	for _, row := range records {
-		rm := RecordMapper{Record: row}
-		id := rm.NextInt()
-		name := rm.NextString()
-		description := rm.NextString()
-		thumbnail := rm.NextString()
-		address := rm.NextString()
-		latitude := rm.NextFloat()
-		longitude := rm.NextFloat()
-		rent := rm.NextInt()
-		doorHeight := rm.NextInt()
-		doorWidth := rm.NextInt()
-		features := rm.NextString()
-		popularity := rm.NextInt()
-		if err := rm.Err(); err != nil {
-			c.Logger().Errorf("failed to read record: %v", err)
-			return c.NoContent(http.StatusBadRequest)
-		}
-		bi.Append(
-			NewEstateSQL().Insert().
-				ValueID(int64(id)).
-				ValueThumbnail(thumbnail).
-				ValueName(name).
-				ValueDescription(description).
-				ValueLatitude(latitude).
-				ValueLongitude(longitude).
-				ValueAddress(address).
-				ValueRent(int64(rent)).
-				ValueDoorHeight(int64(doorHeight)).
-				ValueDoorWidth(int64(doorWidth)).
-				ValueFeatures(features).
-				ValuePopularity(int64(popularity)),
-		)
+// importEstates 入稿を読んでestateに書く。postEstateと非同期の入稿のジョブから呼ぶ
+func importEstates(ctx context.Context, logger echo.Logger, f io.Reader, opts uploadOptions, progress func(int64)) (*UploadResponse, error) {
+	var next func() (uploadRow, error)
+	var err error
+	switch {
+	case opts.Format != uploadFormatCSV:
+		next, err = jsonRowSource(f, opts.Format, estateUploadKeys, estateUpload.row)
+	case opts.Header:
+		next, err = csvHeaderRowSource(f, reflect.TypeOf(Estate{}), estateLoader)
+	default:
+		next = csvRowSource(newUploadCSVReader(f), func(rm *RecordMapper) uploadRow {
+			var u estateUpload
+			u.ID = int64(rm.NextInt())
+			u.Name = rm.NextString()
+			u.Description = rm.NextString()
+			u.Thumbnail = rm.NextString()
+			u.Address = rm.NextString()
+			u.Latitude = rm.NextFloat()
+			u.Longitude = rm.NextFloat()
+			u.Rent = int64(rm.NextInt())
+			u.DoorHeight = int64(rm.NextInt())
+			u.DoorWidth = int64(rm.NextInt())
+			u.Features = rm.NextString()
+			u.Popularity = int64(rm.NextInt())
+			return u.row()
+		})
 	}
-	if _, err := bi.ExecContext(ctx, estateDB); err != nil {
-		c.Logger().Errorf("failed to insert estate: %v", err)
-		return c.NoContent(http.StatusInternalServerError)
+	if err != nil {
+		return nil, err
 	}
 
-	return c.NoContent(http.StatusCreated)
+	col := lowPricedEstates.collector()
+	res, err := estateLoader.load(ctx, estateDB, opts.Mode, col.watch(next, rowRecord[Estate](estateLoader)), progress)
+	if err != nil {
+		return nil, uploadError("estate", err)
+	}
+	if opts.Mode == uploadModeUpsert {
+		lowPricedEstates.clear()
+	} else {
+		lowPricedEstates.addCollected(col)
+	}
+	for _, id := range res.updatedIDs {
+		if err := purgeNginxCache(fmt.Sprintf("/api/estate/%d", id)); err != nil {
+			logger.Errorf("failed to purge nginx cache, id: %v : %v", id, err)
+		}
+	}
+	return res, nil
 }
 
-func searchEstates(c echo.Context) error {
+// estateSearchWhere 検索のクエリパラメータからWHERE句の条件を作る。exceptに指定したファセットの条件は含めない
+func estateSearchWhere(c echo.Context, sc EstateSearchCondition, except string) ([]string, []interface{}, error) {
 	conditions := make([]string, 0)
 	params := make([]interface{}, 0)
 
-	if c.QueryParam("doorHeightRangeId") != "" {
+	if except != "doorHeight" && c.QueryParam("doorHeightRangeId") != "" {
+		r, err := rangeParam(c, "doorHeightRangeId", sc.DoorHeight)
+		if err != nil {
+			return nil, nil, err
+		}
 		conditions = append(conditions, "door_height_range = ?")
-		params = append(params, c.QueryParam("doorHeightRangeId"))
+		params = append(params, r.ID)
 	}
 
 	c.Echo().Logger.Debug("request uri: ", c.Request().RequestURI)
 	c.Echo().Logger.Debug("doorWidthRangeId: ", c.QueryParam("doorWidthRangeId"))
-	if c.QueryParam("doorWidthRangeId") != "" {
+	if except != "doorWidth" && c.QueryParam("doorWidthRangeId") != "" {
+		r, err := rangeParam(c, "doorWidthRangeId", sc.DoorWidth)
+		if err != nil {
+			return nil, nil, err
+		}
 		conditions = append(conditions, "door_width_range = ?")
-		params = append(params, c.QueryParam("doorWidthRangeId"))
+		params = append(params, r.ID)
 	}
 
-	if c.QueryParam("rentRangeId") != "" {
+	if except != "rent" && c.QueryParam("rentRangeId") != "" {
+		r, err := rangeParam(c, "rentRangeId", sc.Rent)
+		if err != nil {
+			return nil, nil, err
+		}
 		conditions = append(conditions, "rent_range = ?")
-		params = append(params, c.QueryParam("rentRangeId"))
+		params = append(params, r.ID)
+	}
+
+	if except != "rent" && (c.QueryParam("rentMin") != "" || c.QueryParam("rentMax") != "") {
+		cs, ps, err := rangeBoundsParam(c, "rent", "rent", sc.Rent)
+		if err != nil {
+			return nil, nil, err
+		}
+		conditions = append(conditions, cs...)
+		params = append(params, ps...)
 	}
 
-	if c.QueryParam("features") != "" {
-		ss := strings.Split(c.QueryParam("features"), ",")
+	if except != "doorWidth" && (c.QueryParam("doorWidthMin") != "" || c.QueryParam("doorWidthMax") != "") {
+		cs, ps, err := rangeBoundsParam(c, "doorWidth", "door_width", sc.DoorWidth)
+		if err != nil {
+			return nil, nil, err
+		}
+		conditions = append(conditions, cs...)
+		params = append(params, ps...)
+	}
+
+	if except != "features" && c.QueryParam("features") != "" {
+		ss, err := listValuesParam(c, "features", sc.Feature)
+		if err != nil {
+			return nil, nil, err
+		}
+		op, err := featuresModeParam(c)
+		if err != nil {
+			return nil, nil, err
+		}
 		if len(ss) > 0 {
 			for _, s := range ss {
 				params = append(params, s)
 			}
 			conditions = append(
 				conditions,
-				fmt.Sprintf("features_array @> ARRAY[?%s]",
-					strings.Repeat(",?", len(ss)-1)),
+				fmt.Sprintf("features_array %s ARRAY[?%s]",
+					op, strings.Repeat(",?", len(ss)-1)),
 			)
 		}
 	}
 
+	q, err := textSearchParam(c)
+	if err != nil {
+		return nil, nil, err
+	}
+	if q != "" {
+		cs, ps := textSearchConditions(q)
+		conditions = append(conditions, cs...)
+		params = append(params, ps...)
+	}
+
+	return conditions, params, nil
+}
+
+func searchEstates(c echo.Context) error {
+	sc := currentSearchConditions().Estate
+	conditions, params, err := estateSearchWhere(c, sc, "")
+	if err != nil {
+		return err
+	}
+
+	q, err := textSearchParam(c)
+	if err != nil {
+		return err
+	}
+
 	if len(conditions) == 0 {
 		c.Echo().Logger.Infof("searchEstates search condition not found")
-		return c.NoContent(http.StatusBadRequest)
+		return newAPIError(http.StatusBadRequest, ErrCodeSearchConditionNotFound, "at least one search condition is required")
+	}
+
+	conditions = append(conditions, visibleEstateCondition)
+
+	sort, err := sortParam(c, estateSorts, q)
+	if err != nil {
+		return err
 	}
 
-	page, err := strconv.Atoi(c.QueryParam("page"))
+	cursor, err := sort.cursorParam(c)
 	if err != nil {
-		c.Logger().Infof("Invalid format page parameter : %v", err)
-		return c.NoContent(http.StatusBadRequest)
+		return err
 	}
 
-	perPage, err := strconv.Atoi(c.QueryParam("perPage"))
+	withCount, err := withCountParam(c)
 	if err != nil {
-		c.Logger().Infof("Invalid format perPage parameter : %v", err)
-		return c.NoContent(http.StatusBadRequest)
+		return err
+	}
+
+	withFacets, err := boolQueryParam(c, "facets", false)
+	if err != nil {
+		return err
+	}
+
+	pagination, err := paginationParam(c)
+	if err != nil {
+		return err
+	}
+	perPage := pagination.PerPage
+	// cursorがあればそこから続きを返すので、古いクライアント向けのpageは使わない
+	offset := pagination.Offset()
+	if cursor != nil {
+		offset = 0
 	}
 
 	searchQuery := "SELECT * FROM estate WHERE "
 	countQuery := "SELECT COUNT(*) FROM estate WHERE "
 	searchCondition := strings.Join(conditions, " AND ")
-	limitOffset := " ORDER BY popularity DESC, id ASC LIMIT ? OFFSET ?"
+	orderBy, orderParams := sort.orderBy()
+	limitOffset := orderBy + " LIMIT ? OFFSET ?"
 
 	ctx := c.Request().Context()
 	var res EstateSearchResponse
-	err = estateDB.GetContext(ctx, &res.Count, countQuery+searchCondition, params...)
-	if err != nil {
-		c.Logger().Errorf("searchEstates DB execution error : %v", err)
-		return c.NoContent(http.StatusInternalServerError)
+	if withCount {
+		var count int64
+		err = estateDB.GetContext(ctx, &count, countQuery+searchCondition, params...)
+		if err != nil {
+			return errInternal(fmt.Errorf("searchEstates DB execution error : %w", err))
+		}
+		res.Count = &count
 	}
 
+	if withFacets {
+		res.Facets = make(map[string][]FacetCount)
+		for _, f := range estateFacets(sc) {
+			// ファセットごとに、そのファセット自身の条件だけを外して数える
+			fcs, fps, err := estateSearchWhere(c, sc, f.name)
+			if err != nil {
+				return err
+			}
+			fcs = append(fcs, visibleEstateCondition)
+			counts, err := f.count(ctx, estateDB, "estate", fcs, fps)
+			if err != nil {
+				return errInternal(fmt.Errorf("searchEstates facet DB execution error : %w", err))
+			}
+			res.Facets[f.name] = counts
+		}
+	}
+
+	if cursor != nil {
+		cond, cursorParams := sort.cursorCondition(cursor)
+		searchCondition += " AND " + cond
+		params = append(params, cursorParams...)
+	}
+
+	// 次のページがあるかを知るために1件多く取る
 	estates := []Estate{}
-	params = append(params, perPage, page*perPage)
+	params = append(params, orderParams...)
+	params = append(params, perPage+1, offset)
 	err = estateDB.SelectContext(ctx, &estates, searchQuery+searchCondition+limitOffset, params...)
 	if err != nil {
-		if err == sql.ErrNoRows {
-			return c.JSON(http.StatusOK, EstateSearchResponse{Count: 0, Estates: []Estate{}})
-		}
-		c.Logger().Errorf("searchEstates DB execution error : %v", err)
-		return c.NoContent(http.StatusInternalServerError)
+		return errInternal(fmt.Errorf("searchEstates DB execution error : %w", err))
 	}
 
+	if len(estates) > perPage {
+		estates = estates[:perPage]
+		last := estates[len(estates)-1]
+		res.NextCursor = sort.nextCursor(last, last.ID)
+	}
 	res.Estates = estates
 
 	return c.JSON(http.StatusOK, res)
 }
 
 func getLowPricedEstate(c echo.Context) error {
-	ctx := c.Request().Context()
-	estates := make([]Estate, 0, Limit)
-	query := `SELECT * FROM estate ORDER BY rent ASC, id ASC LIMIT ?`
-	err := estateDB.SelectContext(ctx, &estates, query, Limit)
+	sc := currentSearchConditions().Estate
+	limit, f, err := lowPricedParams(c, nil, sc.Feature)
 	if err != nil {
-		if err == sql.ErrNoRows {
-			c.Logger().Error("getLowPricedEstate not found")
-			return c.JSON(http.StatusOK, EstateListResponse{[]Estate{}})
+		return err
+	}
+
+	ctx := c.Request().Context()
+	estates, ok := lowPricedEstates.get(f, limit)
+	if !ok {
+		estates, err = lowPricedEstates.load(f, limit, func() ([]Estate, error) {
+			return queryLowPricedEstates(ctx, estateDB, f)
+		})
+		if err != nil {
+			return errInternal(fmt.Errorf("getLowPricedEstate DB execution error : %w", err))
 		}
-		c.Logger().Errorf("getLowPricedEstate DB execution error : %v", err)
-		return c.NoContent(http.StatusInternalServerError)
 	}
 
 	return c.JSON(http.StatusOK, EstateListResponse{Estates: estates})
@@ -817,36 +1428,34 @@ func searchRecommendedEstateWithChair(c echo.Context) error {
 	id, err := strconv.Atoi(c.Param("id"))
 	if err != nil {
 		c.Logger().Infof("Invalid format searchRecommendedEstateWithChair id : %v", err)
-		return c.NoContent(http.StatusBadRequest)
+		return newAPIError(http.StatusBadRequest, ErrCodeInvalidID, "id must be an integer")
 	}
 
 	ctx := c.Request().Context()
 	chair := Chair{}
-	query := `SELECT * FROM chair WHERE id = ?`
+	query := `SELECT * FROM chair WHERE id = ? AND ` + visibleChairCondition
 	err = chairDB.GetContext(ctx, &chair, query, id)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			c.Logger().Infof("Requested chair id \"%v\" not found", id)
-			return c.NoContent(http.StatusBadRequest)
+			return newAPIError(http.StatusBadRequest, ErrCodeChairNotFound, "chair not found")
 		}
-		c.Logger().Errorf("Database execution error : %v", err)
-		return c.NoContent(http.StatusInternalServerError)
+		return errInternal(fmt.Errorf("Database execution error : %w", err))
 	}
 
 	var estates []Estate
 	sorted := []int64{chair.Width, chair.Depth, chair.Height}
 	slices.Sort(sorted)
 	l1, l2 := sorted[0], sorted[1]
-	query = `SELECT * from (select * from estate where door_width >= ? AND door_height >= ? ORDER BY popularity DESC ,id limit ?) as t
+	query = `SELECT * from (select * from estate where door_width >= ? AND door_height >= ? AND ` + visibleEstateCondition + ` ORDER BY popularity DESC ,id limit ?) as t
 union
-SELECT * from  (select * from estate where door_width >= ? AND door_height >= ? ORDER BY popularity DESC ,id limit ?) as t2 ORDER BY popularity DESC ,id limit ?;`
+SELECT * from  (select * from estate where door_width >= ? AND door_height >= ? AND ` + visibleEstateCondition + ` ORDER BY popularity DESC ,id limit ?) as t2 ORDER BY popularity DESC ,id limit ?;`
 	err = estateDB.SelectContext(ctx, &estates, query, l1, l2, Limit, l2, l1, Limit, Limit)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return c.JSON(http.StatusOK, EstateListResponse{[]Estate{}})
 		}
-		c.Logger().Errorf("Database execution error : %v", err)
-		return c.NoContent(http.StatusInternalServerError)
+		return errInternal(fmt.Errorf("Database execution error : %w", err))
 	}
 
 	return c.JSON(http.StatusOK, EstateListResponse{Estates: estates})
@@ -854,27 +1463,22 @@ SELECT * from  (select * from estate where door_width >= ? AND door_height >= ?
 
 func searchEstateNazotte(c echo.Context) error {
 	coordinates := Coordinates{}
-	err := c.Bind(&coordinates)
+	err := bindAndValidate(c, &coordinates)
 	if err != nil {
 		c.Echo().Logger.Infof("post search estate nazotte failed : %v", err)
-		return c.NoContent(http.StatusBadRequest)
-	}
-
-	if len(coordinates.Coordinates) == 0 {
-		return c.NoContent(http.StatusBadRequest)
+		return newValidationError(err)
 	}
 
 	ctx := c.Request().Context()
 	b := coordinates.getBoundingBox()
 	estatesInBoundingBox := []Estate{}
-	query := `SELECT * FROM estate WHERE latitude <= ? AND latitude >= ? AND longitude <= ? AND longitude >= ? ORDER BY popularity DESC, id ASC`
+	query := `SELECT * FROM estate WHERE latitude <= ? AND latitude >= ? AND longitude <= ? AND longitude >= ? AND ` + visibleEstateCondition + ` ORDER BY popularity DESC, id ASC`
 	err = estateDB.SelectContext(ctx, &estatesInBoundingBox, query, b.BottomRightCorner.Latitude, b.TopLeftCorner.Latitude, b.BottomRightCorner.Longitude, b.TopLeftCorner.Longitude)
 	if err == sql.ErrNoRows {
 		c.Echo().Logger.Infof("select * from estate where latitude ...", err)
-		return c.JSON(http.StatusOK, EstateSearchResponse{Count: 0, Estates: []Estate{}})
+		return c.JSON(http.StatusOK, EstateSearchResponse{Count: new(int64), Estates: []Estate{}})
 	} else if err != nil {
-		c.Echo().Logger.Errorf("database execution error : %v", err)
-		return c.NoContent(http.StatusInternalServerError)
+		return errInternal(fmt.Errorf("database execution error : %w", err))
 	}
 
 	estatesInPolygon := []Estate{}
@@ -894,47 +1498,45 @@ func searchEstateNazotte(c echo.Context) error {
 	} else {
 		re.Estates = estatesInPolygon
 	}
-	re.Count = int64(len(re.Estates))
+	count := int64(len(re.Estates))
+	re.Count = &count
 
 	return c.JSON(http.StatusOK, re)
 }
 
 func postEstateRequestDocument(c echo.Context) error {
-	m := echo.Map{}
-	if err := c.Bind(&m); err != nil {
+	var req EstateRequestDocumentRequest
+	if err := bindAndValidate(c, &req); err != nil {
 		c.Echo().Logger.Infof("post request document failed : %v", err)
-		return c.NoContent(http.StatusInternalServerError)
-	}
-
-	_, ok := m["email"].(string)
-	if !ok {
-		c.Echo().Logger.Info("post request document failed : email not found in request body")
-		return c.NoContent(http.StatusBadRequest)
+		return newValidationError(err)
 	}
 
 	id, err := strconv.Atoi(c.Param("id"))
 	if err != nil {
 		c.Echo().Logger.Infof("post request document failed : %v", err)
-		return c.NoContent(http.StatusBadRequest)
+		return newAPIError(http.StatusBadRequest, ErrCodeInvalidID, "id must be an integer")
 	}
 
 	ctx := c.Request().Context()
 	estate := Estate{}
-	query := `SELECT * FROM estate WHERE id = ?`
+	query := `SELECT * FROM estate WHERE id = ? AND ` + visibleEstateCondition
 	err = estateDB.GetContext(ctx, &estate, query, id)
 	if err != nil {
 		if err == sql.ErrNoRows {
-			return c.NoContent(http.StatusNotFound)
+			return newAPIError(http.StatusNotFound, ErrCodeEstateNotFound, "estate not found")
 		}
-		c.Logger().Errorf("postEstateRequestDocument DB execution error : %v", err)
-		return c.NoContent(http.StatusInternalServerError)
+		return errInternal(fmt.Errorf("postEstateRequestDocument DB execution error : %w", err))
+	}
+
+	if err := insertDocumentRequest(ctx, estate.ID, req.Email); err != nil {
+		return errInternal(fmt.Errorf("postEstateRequestDocument DB execution error : %w", err))
 	}
 
 	return c.NoContent(http.StatusOK)
 }
 
 func getEstateSearchCondition(c echo.Context) error {
-	return c.JSON(http.StatusOK, estateSearchCondition)
+	return c.JSON(http.StatusOK, currentSearchConditions().Estate)
 }
 
 func (cs Coordinates) getBoundingBox() BoundingBox {
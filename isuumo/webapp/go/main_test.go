@@ -0,0 +1,185 @@
+package main
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+)
+
+func newTestContext(query string) echo.Context {
+	req := httptest.NewRequest(http.MethodGet, "/?"+query, nil)
+	return echo.New().NewContext(req, httptest.NewRecorder())
+}
+
+// testRangeCondition 0〜100、100〜200、200〜のバケット
+var testRangeCondition = RangeCondition{
+	Ranges: []*Range{
+		{ID: 0, Min: -1, Max: 100},
+		{ID: 1, Min: 100, Max: 200},
+		{ID: 2, Min: 200, Max: -1},
+	},
+}
+
+func TestChairSearchWhere(t *testing.T) {
+	sc := ChairSearchCondition{Price: testRangeCondition, Width: testRangeCondition}
+	tests := []struct {
+		name           string
+		query          string
+		except         string
+		wantConditions []string
+		wantParams     []interface{}
+	}{
+		{
+			name:           "priceMax only",
+			query:          "priceMax=150",
+			wantConditions: []string{"price_range BETWEEN ? AND ?", "price <= ?"},
+			wantParams:     []interface{}{int64(0), int64(1), 150},
+		},
+		{
+			name:   "priceMax only except price",
+			query:  "priceMax=150",
+			except: "price",
+		},
+		{
+			name:   "priceMin only except price",
+			query:  "priceMin=150",
+			except: "price",
+		},
+		{
+			name:   "widthMax only except width",
+			query:  "widthMax=150",
+			except: "width",
+		},
+		{
+			name:           "priceMax and widthMax except price",
+			query:          "priceMax=150&widthMax=199",
+			except:         "price",
+			wantConditions: []string{"width_range BETWEEN ? AND ?"},
+			wantParams:     []interface{}{int64(0), int64(1)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conditions, params, err := chairSearchWhere(newTestContext(tt.query), sc, tt.except)
+			if err != nil {
+				t.Fatalf("chairSearchWhere() error = %v", err)
+			}
+			if len(conditions) != len(tt.wantConditions) || len(conditions) > 0 && !reflect.DeepEqual(conditions, tt.wantConditions) {
+				t.Errorf("chairSearchWhere() conditions = %q, want %q", conditions, tt.wantConditions)
+			}
+			if len(params) != len(tt.wantParams) || len(params) > 0 && !reflect.DeepEqual(params, tt.wantParams) {
+				t.Errorf("chairSearchWhere() params = %v, want %v", params, tt.wantParams)
+			}
+		})
+	}
+}
+
+func TestEstateSearchWhere(t *testing.T) {
+	sc := EstateSearchCondition{Rent: testRangeCondition, DoorWidth: testRangeCondition}
+	tests := []struct {
+		name      string
+		query     string
+		except    string
+		wantCount int
+	}{
+		{name: "rentMax only", query: "rentMax=150", wantCount: 2},
+		{name: "rentMax only except rent", query: "rentMax=150", except: "rent"},
+		{name: "doorWidthMax only", query: "doorWidthMax=150", wantCount: 2},
+		{name: "doorWidthMax only except doorWidth", query: "doorWidthMax=150", except: "doorWidth"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conditions, _, err := estateSearchWhere(newTestContext(tt.query), sc, tt.except)
+			if err != nil {
+				t.Fatalf("estateSearchWhere() error = %v", err)
+			}
+			if len(conditions) != tt.wantCount {
+				t.Errorf("estateSearchWhere() conditions = %q, want %d conditions", conditions, tt.wantCount)
+			}
+		})
+	}
+}
+
+func TestRangeBoundsParam(t *testing.T) {
+	tests := []struct {
+		name           string
+		query          string
+		wantConditions []string
+		wantParams     []interface{}
+		wantCode       ErrorCode
+	}{
+		{
+			name: "no bounds",
+		},
+		{
+			name:           "one whole bucket",
+			query:          "priceMin=100&priceMax=199",
+			wantConditions: []string{"price_range = ?"},
+			wantParams:     []interface{}{int64(1)},
+		},
+		{
+			name:           "max at the end of the first bucket",
+			query:          "priceMax=99",
+			wantConditions: []string{"price_range = ?"},
+			wantParams:     []interface{}{int64(0)},
+		},
+		{
+			name:           "min at the start of a bucket",
+			query:          "priceMin=100",
+			wantConditions: []string{"price_range BETWEEN ? AND ?"},
+			wantParams:     []interface{}{int64(1), int64(2)},
+		},
+		{
+			name:           "min in the middle of a bucket",
+			query:          "priceMin=150",
+			wantConditions: []string{"price_range BETWEEN ? AND ?", "price >= ?"},
+			wantParams:     []interface{}{int64(1), int64(2), 150},
+		},
+		{
+			name:           "all buckets with both ends cut",
+			query:          "priceMin=50&priceMax=250",
+			wantConditions: []string{"price >= ?", "price <= ?"},
+			wantParams:     []interface{}{50, 250},
+		},
+		{
+			name:     "min greater than max",
+			query:    "priceMin=200&priceMax=100",
+			wantCode: ErrCodeInvalidRangeBound,
+		},
+		{
+			name:     "not an integer",
+			query:    "priceMax=abc",
+			wantCode: ErrCodeInvalidRangeBound,
+		},
+		{
+			name:     "negative",
+			query:    "priceMin=-5",
+			wantCode: ErrCodeInvalidRangeBound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conditions, params, err := rangeBoundsParam(newTestContext(tt.query), "price", "price", testRangeCondition)
+			if tt.wantCode != "" {
+				var ae *APIError
+				if !errors.As(err, &ae) || ae.Code != tt.wantCode {
+					t.Fatalf("rangeBoundsParam() error = %v, want code %s", err, tt.wantCode)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("rangeBoundsParam() error = %v", err)
+			}
+			if len(conditions) != len(tt.wantConditions) || len(conditions) > 0 && !reflect.DeepEqual(conditions, tt.wantConditions) {
+				t.Errorf("rangeBoundsParam() conditions = %q, want %q", conditions, tt.wantConditions)
+			}
+			if len(params) != len(tt.wantParams) || len(params) > 0 && !reflect.DeepEqual(params, tt.wantParams) {
+				t.Errorf("rangeBoundsParam() params = %v, want %v", params, tt.wantParams)
+			}
+		})
+	}
+}
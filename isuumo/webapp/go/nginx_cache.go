@@ -0,0 +1,23 @@
+package main
+
+import (
+	"crypto/md5"
+	"encoding/hex"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+)
+
+// purgeNginxCache nginxのproxy_cacheからuriのエントリを消す。
+// OpenRestyにはpurgeのモジュールがないので、nginx.confのproxy_cache_pathと同じ規則(levels=1:2)でキャッシュファイルを直接消す。
+// キーは既定のproxy_cache_keyの $scheme$proxy_host$request_uri で、NGINX_CACHE_KEY_PREFIXがその前半にあたる
+func purgeNginxCache(uri string) error {
+	sum := md5.Sum([]byte(getEnv("NGINX_CACHE_KEY_PREFIX", "httplocalhost:1323") + uri))
+	name := hex.EncodeToString(sum[:])
+	path := filepath.Join(getEnv("NGINX_CACHE_DIR", "/var/cache/nginx/cache"), name[len(name)-1:], name[len(name)-3:len(name)-1], name)
+	if err := os.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	return nil
+}
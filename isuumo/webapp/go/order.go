@@ -0,0 +1,68 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/mackee/go-sqlla/v2"
+)
+
+// insertOrder 購入履歴を1行追加する。在庫を減らしたのと同じトランザクションで呼ぶこと
+func insertOrder(ctx context.Context, db sqlla.DB, chairID int64, email string, price int64, quantity int64) error {
+	_, err := NewOrderSQL().Insert().
+		ValueChairID(chairID).
+		ValueEmail(email).
+		ValuePrice(price).
+		ValueQuantity(quantity).
+		ExecContext(ctx, db)
+	return err
+}
+
+// OrderListRequest ordersのクエリパラメータ
+type OrderListRequest struct {
+	Email string `query:"email" json:"email" validate:"required,email"`
+}
+
+// getOrders emailごとの購入履歴を新しい順に返す。cursorには前のページのnextCursorを渡す。
+// 購入した人の個人情報なので、ルートでrequireAdminTokenを付けて管理用のトークンがあるときだけ返す
+func getOrders(c echo.Context) error {
+	var req OrderListRequest
+	if err := bindAndValidate(c, &req); err != nil {
+		c.Echo().Logger.Infof("get orders failed : %v", err)
+		return newValidationError(err)
+	}
+
+	q := NewOrderSQL().Select().Email(req.Email)
+	if cursor := c.QueryParam("cursor"); cursor != "" {
+		lastID, err := strconv.ParseInt(cursor, 10, 64)
+		if err != nil {
+			c.Echo().Logger.Infof("Invalid format cursor parameter : %v", err)
+			return newAPIError(http.StatusBadRequest, ErrCodeInvalidCursor, "cursor is invalid")
+		}
+		q = q.ID(lastID, sqlla.OpLess)
+	}
+
+	perPage, err := perPageParam(c)
+	if err != nil {
+		return err
+	}
+
+	// 次のページがあるかを知るために1件多く取る
+	ctx := c.Request().Context()
+	orders, err := q.OrderByID(sqlla.Desc).Limit(uint64(perPage)+1).AllContext(ctx, chairDB)
+	if err != nil {
+		return errInternal(fmt.Errorf("getOrders DB execution error : %w", err))
+	}
+
+	var res OrderListResponse
+	if len(orders) > perPage {
+		orders = orders[:perPage]
+		res.NextCursor = strconv.FormatInt(orders[len(orders)-1].ID, 10)
+	}
+	res.Orders = orders
+
+	return c.JSON(http.StatusOK, res)
+}
@@ -0,0 +1,1092 @@
+// Code generated by github.com/mackee/go-sqlla/v2/cmd/sqlla - DO NOT EDIT.
+package main
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"database/sql"
+	"time"
+
+	"github.com/mackee/go-sqlla/v2"
+)
+
+type orderSQL struct {
+	where sqlla.Where
+}
+
+func NewOrderSQL() orderSQL {
+	q := orderSQL{}
+	return q
+}
+
+var orderAllColumns = []string{
+	"`id`", "`chair_id`", "`email`", "`price`", "`quantity`", "`created_at`",
+}
+
+type orderSelectSQL struct {
+	orderSQL
+	Columns     []string
+	order       string
+	limit       *uint64
+	offset      *uint64
+	tableAlias  string
+	joinClauses []string
+
+	additionalWhereClause     string
+	additionalWhereClauseArgs []interface{}
+
+	groupByColumns []string
+
+	isForUpdate bool
+}
+
+func (q orderSQL) Select() orderSelectSQL {
+	return orderSelectSQL{
+		q,
+		orderAllColumns,
+		"",
+		nil,
+		nil,
+		"",
+		nil,
+		"",
+		nil,
+		nil,
+		false,
+	}
+}
+
+func (q orderSelectSQL) Or(qs ...orderSelectSQL) orderSelectSQL {
+	ws := make([]sqlla.Where, 0, len(qs))
+	for _, q := range qs {
+		ws = append(ws, q.where)
+	}
+	q.where = append(q.where, sqlla.ExprOr(ws))
+	return q
+}
+
+func (q orderSelectSQL) Limit(l uint64) orderSelectSQL {
+	q.limit = &l
+	return q
+}
+
+func (q orderSelectSQL) Offset(o uint64) orderSelectSQL {
+	q.offset = &o
+	return q
+}
+
+func (q orderSelectSQL) ForUpdate() orderSelectSQL {
+	q.isForUpdate = true
+	return q
+}
+
+func (q orderSelectSQL) TableAlias(alias string) orderSelectSQL {
+	q.tableAlias = "`" + alias + "`"
+	return q
+}
+
+func (q orderSelectSQL) SetColumns(columns ...string) orderSelectSQL {
+	q.Columns = make([]string, 0, len(columns))
+	for _, column := range columns {
+		if strings.ContainsAny(column, "(.`") {
+			q.Columns = append(q.Columns, column)
+		} else {
+			q.Columns = append(q.Columns, "`"+column+"`")
+		}
+	}
+	return q
+}
+
+func (q orderSelectSQL) JoinClause(clause string) orderSelectSQL {
+	q.joinClauses = append(q.joinClauses, clause)
+	return q
+}
+
+func (q orderSelectSQL) AdditionalWhereClause(clause string, args ...interface{}) orderSelectSQL {
+	q.additionalWhereClause = clause
+	q.additionalWhereClauseArgs = args
+	return q
+}
+
+func (q orderSelectSQL) appendColumnPrefix(column string) string {
+	if q.tableAlias == "" || strings.ContainsAny(column, "(.") {
+		return column
+	}
+	return q.tableAlias + "." + column
+}
+
+func (q orderSelectSQL) GroupBy(columns ...string) orderSelectSQL {
+	q.groupByColumns = make([]string, 0, len(columns))
+	for _, column := range columns {
+		if strings.ContainsAny(column, "(.`") {
+			q.groupByColumns = append(q.groupByColumns, column)
+		} else {
+			q.groupByColumns = append(q.groupByColumns, "`"+column+"`")
+		}
+	}
+	return q
+}
+
+func (q orderSelectSQL) ID(v int64, exprs ...sqlla.Operator) orderSelectSQL {
+	var op sqlla.Operator
+	if len(exprs) == 0 {
+		op = sqlla.OpEqual
+	} else {
+		op = exprs[0]
+	}
+	where := sqlla.ExprInt64{Value: v, Op: op, Column: q.appendColumnPrefix("`id`")}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q orderSelectSQL) IDIn(vs ...int64) orderSelectSQL {
+	where := sqlla.ExprMultiInt64{Values: vs, Op: sqlla.MakeInOperator(len(vs)), Column: q.appendColumnPrefix("`id`")}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q orderSelectSQL) OrderByID(order sqlla.Order) orderSelectSQL {
+	q.order = " ORDER BY " + q.appendColumnPrefix("`id`")
+	if order == sqlla.Asc {
+		q.order += " ASC"
+	} else {
+		q.order += " DESC"
+	}
+
+	return q
+}
+
+func (q orderSelectSQL) ChairID(v int64, exprs ...sqlla.Operator) orderSelectSQL {
+	var op sqlla.Operator
+	if len(exprs) == 0 {
+		op = sqlla.OpEqual
+	} else {
+		op = exprs[0]
+	}
+	where := sqlla.ExprInt64{Value: v, Op: op, Column: q.appendColumnPrefix("`chair_id`")}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q orderSelectSQL) ChairIDIn(vs ...int64) orderSelectSQL {
+	where := sqlla.ExprMultiInt64{Values: vs, Op: sqlla.MakeInOperator(len(vs)), Column: q.appendColumnPrefix("`chair_id`")}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q orderSelectSQL) OrderByChairID(order sqlla.Order) orderSelectSQL {
+	q.order = " ORDER BY " + q.appendColumnPrefix("`chair_id`")
+	if order == sqlla.Asc {
+		q.order += " ASC"
+	} else {
+		q.order += " DESC"
+	}
+
+	return q
+}
+
+func (q orderSelectSQL) Email(v string, exprs ...sqlla.Operator) orderSelectSQL {
+	var op sqlla.Operator
+	if len(exprs) == 0 {
+		op = sqlla.OpEqual
+	} else {
+		op = exprs[0]
+	}
+	where := sqlla.ExprString{Value: v, Op: op, Column: q.appendColumnPrefix("`email`")}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q orderSelectSQL) EmailIn(vs ...string) orderSelectSQL {
+	where := sqlla.ExprMultiString{Values: vs, Op: sqlla.MakeInOperator(len(vs)), Column: q.appendColumnPrefix("`email`")}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q orderSelectSQL) OrderByEmail(order sqlla.Order) orderSelectSQL {
+	q.order = " ORDER BY " + q.appendColumnPrefix("`email`")
+	if order == sqlla.Asc {
+		q.order += " ASC"
+	} else {
+		q.order += " DESC"
+	}
+
+	return q
+}
+
+func (q orderSelectSQL) Price(v int64, exprs ...sqlla.Operator) orderSelectSQL {
+	var op sqlla.Operator
+	if len(exprs) == 0 {
+		op = sqlla.OpEqual
+	} else {
+		op = exprs[0]
+	}
+	where := sqlla.ExprInt64{Value: v, Op: op, Column: q.appendColumnPrefix("`price`")}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q orderSelectSQL) PriceIn(vs ...int64) orderSelectSQL {
+	where := sqlla.ExprMultiInt64{Values: vs, Op: sqlla.MakeInOperator(len(vs)), Column: q.appendColumnPrefix("`price`")}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q orderSelectSQL) OrderByPrice(order sqlla.Order) orderSelectSQL {
+	q.order = " ORDER BY " + q.appendColumnPrefix("`price`")
+	if order == sqlla.Asc {
+		q.order += " ASC"
+	} else {
+		q.order += " DESC"
+	}
+
+	return q
+}
+
+func (q orderSelectSQL) Quantity(v int64, exprs ...sqlla.Operator) orderSelectSQL {
+	var op sqlla.Operator
+	if len(exprs) == 0 {
+		op = sqlla.OpEqual
+	} else {
+		op = exprs[0]
+	}
+	where := sqlla.ExprInt64{Value: v, Op: op, Column: q.appendColumnPrefix("`quantity`")}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q orderSelectSQL) QuantityIn(vs ...int64) orderSelectSQL {
+	where := sqlla.ExprMultiInt64{Values: vs, Op: sqlla.MakeInOperator(len(vs)), Column: q.appendColumnPrefix("`quantity`")}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q orderSelectSQL) OrderByQuantity(order sqlla.Order) orderSelectSQL {
+	q.order = " ORDER BY " + q.appendColumnPrefix("`quantity`")
+	if order == sqlla.Asc {
+		q.order += " ASC"
+	} else {
+		q.order += " DESC"
+	}
+
+	return q
+}
+
+func (q orderSelectSQL) CreatedAt(v time.Time, exprs ...sqlla.Operator) orderSelectSQL {
+	var op sqlla.Operator
+	if len(exprs) == 0 {
+		op = sqlla.OpEqual
+	} else {
+		op = exprs[0]
+	}
+	where := sqlla.ExprTime{Value: v, Op: op, Column: q.appendColumnPrefix("`created_at`")}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q orderSelectSQL) CreatedAtIn(vs ...time.Time) orderSelectSQL {
+	where := sqlla.ExprMultiTime{Values: vs, Op: sqlla.MakeInOperator(len(vs)), Column: q.appendColumnPrefix("`created_at`")}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q orderSelectSQL) OrderByCreatedAt(order sqlla.Order) orderSelectSQL {
+	q.order = " ORDER BY " + q.appendColumnPrefix("`created_at`")
+	if order == sqlla.Asc {
+		q.order += " ASC"
+	} else {
+		q.order += " DESC"
+	}
+
+	return q
+}
+
+func (q orderSelectSQL) ToSql() (string, []interface{}, error) {
+	columns := strings.Join(q.Columns, ", ")
+	wheres, vs, err := q.where.ToSql()
+	if err != nil {
+		return "", nil, err
+	}
+
+	tableName := "orders"
+	if q.tableAlias != "" {
+		tableName = tableName + " AS " + q.tableAlias
+		pcs := make([]string, 0, len(q.Columns))
+		for _, column := range q.Columns {
+			pcs = append(pcs, q.appendColumnPrefix(column))
+		}
+		columns = strings.Join(pcs, ", ")
+	}
+	query := "SELECT " + columns + " FROM " + tableName
+	if len(q.joinClauses) > 0 {
+		jc := strings.Join(q.joinClauses, " ")
+		query += " " + jc
+	}
+	if wheres != "" {
+		query += " WHERE" + wheres
+	}
+	if q.additionalWhereClause != "" {
+		query += " " + q.additionalWhereClause
+		if len(q.additionalWhereClauseArgs) > 0 {
+			vs = append(vs, q.additionalWhereClauseArgs...)
+		}
+	}
+	if len(q.groupByColumns) > 0 {
+		query += " GROUP BY "
+		gbcs := make([]string, 0, len(q.groupByColumns))
+		for _, column := range q.groupByColumns {
+			gbcs = append(gbcs, q.appendColumnPrefix(column))
+		}
+		query += strings.Join(gbcs, ", ")
+	}
+	query += q.order
+	if q.limit != nil {
+		query += " LIMIT " + strconv.FormatUint(*q.limit, 10)
+	}
+	if q.offset != nil {
+		query += " OFFSET " + strconv.FormatUint(*q.offset, 10)
+	}
+
+	if q.isForUpdate {
+		query += " FOR UPDATE"
+	}
+
+	return query + ";", vs, nil
+}
+
+func (q orderSelectSQL) Single(db sqlla.DB) (Order, error) {
+	q.Columns = orderAllColumns
+	query, args, err := q.ToSql()
+	if err != nil {
+		return Order{}, err
+	}
+
+	row := db.QueryRow(query, args...)
+	return q.Scan(row)
+}
+
+func (q orderSelectSQL) SingleContext(ctx context.Context, db sqlla.DB) (Order, error) {
+	q.Columns = orderAllColumns
+	query, args, err := q.ToSql()
+	if err != nil {
+		return Order{}, err
+	}
+
+	row := db.QueryRowContext(ctx, query, args...)
+	return q.Scan(row)
+}
+
+func (q orderSelectSQL) All(db sqlla.DB) ([]Order, error) {
+	rs := make([]Order, 0, 10)
+	q.Columns = orderAllColumns
+	query, args, err := q.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		r, err := q.Scan(rows)
+		if err != nil {
+			return nil, err
+		}
+		rs = append(rs, r)
+	}
+	return rs, nil
+}
+
+func (q orderSelectSQL) AllContext(ctx context.Context, db sqlla.DB) ([]Order, error) {
+	rs := make([]Order, 0, 10)
+	q.Columns = orderAllColumns
+	query, args, err := q.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		r, err := q.Scan(rows)
+		if err != nil {
+			return nil, err
+		}
+		rs = append(rs, r)
+	}
+	return rs, nil
+}
+
+func (q orderSelectSQL) Scan(s sqlla.Scanner) (Order, error) {
+	var row Order
+	err := s.Scan(
+		&row.ID,
+		&row.ChairID,
+		&row.Email,
+		&row.Price,
+		&row.Quantity,
+		&row.CreatedAt,
+	)
+	return row, err
+}
+
+type orderUpdateSQL struct {
+	orderSQL
+	setMap  sqlla.SetMap
+	Columns []string
+}
+
+func (q orderSQL) Update() orderUpdateSQL {
+	return orderUpdateSQL{
+		orderSQL: q,
+		setMap:   sqlla.SetMap{},
+	}
+}
+
+func (q orderUpdateSQL) SetID(v int64) orderUpdateSQL {
+	q.setMap["`id`"] = v
+	return q
+}
+
+func (q orderUpdateSQL) WhereID(v int64, exprs ...sqlla.Operator) orderUpdateSQL {
+	var op sqlla.Operator
+	if len(exprs) == 0 {
+		op = sqlla.OpEqual
+	} else {
+		op = exprs[0]
+	}
+	where := sqlla.ExprInt64{Value: v, Op: op, Column: "`id`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q orderUpdateSQL) WhereIDIn(vs ...int64) orderUpdateSQL {
+	where := sqlla.ExprMultiInt64{Values: vs, Op: sqlla.MakeInOperator(len(vs)), Column: "`id`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q orderUpdateSQL) SetChairID(v int64) orderUpdateSQL {
+	q.setMap["`chair_id`"] = v
+	return q
+}
+
+func (q orderUpdateSQL) WhereChairID(v int64, exprs ...sqlla.Operator) orderUpdateSQL {
+	var op sqlla.Operator
+	if len(exprs) == 0 {
+		op = sqlla.OpEqual
+	} else {
+		op = exprs[0]
+	}
+	where := sqlla.ExprInt64{Value: v, Op: op, Column: "`chair_id`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q orderUpdateSQL) WhereChairIDIn(vs ...int64) orderUpdateSQL {
+	where := sqlla.ExprMultiInt64{Values: vs, Op: sqlla.MakeInOperator(len(vs)), Column: "`chair_id`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q orderUpdateSQL) SetEmail(v string) orderUpdateSQL {
+	q.setMap["`email`"] = v
+	return q
+}
+
+func (q orderUpdateSQL) WhereEmail(v string, exprs ...sqlla.Operator) orderUpdateSQL {
+	var op sqlla.Operator
+	if len(exprs) == 0 {
+		op = sqlla.OpEqual
+	} else {
+		op = exprs[0]
+	}
+	where := sqlla.ExprString{Value: v, Op: op, Column: "`email`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q orderUpdateSQL) WhereEmailIn(vs ...string) orderUpdateSQL {
+	where := sqlla.ExprMultiString{Values: vs, Op: sqlla.MakeInOperator(len(vs)), Column: "`email`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q orderUpdateSQL) SetPrice(v int64) orderUpdateSQL {
+	q.setMap["`price`"] = v
+	return q
+}
+
+func (q orderUpdateSQL) WherePrice(v int64, exprs ...sqlla.Operator) orderUpdateSQL {
+	var op sqlla.Operator
+	if len(exprs) == 0 {
+		op = sqlla.OpEqual
+	} else {
+		op = exprs[0]
+	}
+	where := sqlla.ExprInt64{Value: v, Op: op, Column: "`price`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q orderUpdateSQL) WherePriceIn(vs ...int64) orderUpdateSQL {
+	where := sqlla.ExprMultiInt64{Values: vs, Op: sqlla.MakeInOperator(len(vs)), Column: "`price`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q orderUpdateSQL) SetQuantity(v int64) orderUpdateSQL {
+	q.setMap["`quantity`"] = v
+	return q
+}
+
+func (q orderUpdateSQL) WhereQuantity(v int64, exprs ...sqlla.Operator) orderUpdateSQL {
+	var op sqlla.Operator
+	if len(exprs) == 0 {
+		op = sqlla.OpEqual
+	} else {
+		op = exprs[0]
+	}
+	where := sqlla.ExprInt64{Value: v, Op: op, Column: "`quantity`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q orderUpdateSQL) WhereQuantityIn(vs ...int64) orderUpdateSQL {
+	where := sqlla.ExprMultiInt64{Values: vs, Op: sqlla.MakeInOperator(len(vs)), Column: "`quantity`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q orderUpdateSQL) SetCreatedAt(v time.Time) orderUpdateSQL {
+	q.setMap["`created_at`"] = v
+	return q
+}
+
+func (q orderUpdateSQL) WhereCreatedAt(v time.Time, exprs ...sqlla.Operator) orderUpdateSQL {
+	var op sqlla.Operator
+	if len(exprs) == 0 {
+		op = sqlla.OpEqual
+	} else {
+		op = exprs[0]
+	}
+	where := sqlla.ExprTime{Value: v, Op: op, Column: "`created_at`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q orderUpdateSQL) WhereCreatedAtIn(vs ...time.Time) orderUpdateSQL {
+	where := sqlla.ExprMultiTime{Values: vs, Op: sqlla.MakeInOperator(len(vs)), Column: "`created_at`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q orderUpdateSQL) ToSql() (string, []interface{}, error) {
+	var err error
+	var s interface{} = Order{}
+	if t, ok := s.(orderDefaultUpdateHooker); ok {
+		q, err = t.DefaultUpdateHook(q)
+		if err != nil {
+			return "", []interface{}{}, err
+		}
+	}
+	setColumns, svs, err := q.setMap.ToUpdateSql()
+	if err != nil {
+		return "", []interface{}{}, err
+	}
+	wheres, wvs, err := q.where.ToSql()
+	if err != nil {
+		return "", []interface{}{}, err
+	}
+
+	query := "UPDATE orders SET" + setColumns
+	if wheres != "" {
+		query += " WHERE" + wheres
+	}
+
+	return query + ";", append(svs, wvs...), nil
+}
+func (q orderUpdateSQL) Exec(db sqlla.DB) (sql.Result, error) {
+	query, args, err := q.ToSql()
+	if err != nil {
+		return nil, err
+	}
+	return db.Exec(query, args...)
+}
+
+func (q orderUpdateSQL) ExecContext(ctx context.Context, db sqlla.DB) (sql.Result, error) {
+	query, args, err := q.ToSql()
+	if err != nil {
+		return nil, err
+	}
+	return db.ExecContext(ctx, query, args...)
+}
+
+type orderDefaultUpdateHooker interface {
+	DefaultUpdateHook(orderUpdateSQL) (orderUpdateSQL, error)
+}
+
+type orderInsertSQL struct {
+	orderSQL
+	setMap  sqlla.SetMap
+	Columns []string
+}
+
+func (q orderSQL) Insert() orderInsertSQL {
+	return orderInsertSQL{
+		orderSQL: q,
+		setMap:   sqlla.SetMap{},
+	}
+}
+
+func (q orderInsertSQL) ValueID(v int64) orderInsertSQL {
+	q.setMap["`id`"] = v
+	return q
+}
+
+func (q orderInsertSQL) ValueChairID(v int64) orderInsertSQL {
+	q.setMap["`chair_id`"] = v
+	return q
+}
+
+func (q orderInsertSQL) ValueEmail(v string) orderInsertSQL {
+	q.setMap["`email`"] = v
+	return q
+}
+
+func (q orderInsertSQL) ValuePrice(v int64) orderInsertSQL {
+	q.setMap["`price`"] = v
+	return q
+}
+
+func (q orderInsertSQL) ValueQuantity(v int64) orderInsertSQL {
+	q.setMap["`quantity`"] = v
+	return q
+}
+
+func (q orderInsertSQL) ValueCreatedAt(v time.Time) orderInsertSQL {
+	q.setMap["`created_at`"] = v
+	return q
+}
+
+func (q orderInsertSQL) ToSql() (string, []interface{}, error) {
+	query, vs, err := q.orderInsertSQLToSql()
+	if err != nil {
+		return "", []interface{}{}, err
+	}
+	return query + ";", vs, nil
+}
+
+func (q orderInsertSQL) orderInsertSQLToSql() (string, []interface{}, error) {
+	var err error
+	var s interface{} = Order{}
+	if t, ok := s.(orderDefaultInsertHooker); ok {
+		q, err = t.DefaultInsertHook(q)
+		if err != nil {
+			return "", []interface{}{}, err
+		}
+	}
+	qs, vs, err := q.setMap.ToInsertSql()
+	if err != nil {
+		return "", []interface{}{}, err
+	}
+
+	query := "INSERT INTO orders " + qs
+
+	return query, vs, nil
+}
+
+func (q orderInsertSQL) OnDuplicateKeyUpdate() orderInsertOnDuplicateKeyUpdateSQL {
+	return orderInsertOnDuplicateKeyUpdateSQL{
+		insertSQL:               q,
+		onDuplicateKeyUpdateMap: sqlla.SetMap{},
+	}
+}
+
+func (q orderInsertSQL) Exec(db sqlla.DB) (sql.Result, error) {
+	query, args, err := q.ToSql()
+	if err != nil {
+
+		return nil, err
+	}
+	result, err := db.Exec(query, args...)
+	return result, err
+}
+
+func (q orderInsertSQL) ExecContext(ctx context.Context, db sqlla.DB) (sql.Result, error) {
+	query, args, err := q.ToSql()
+	if err != nil {
+
+		return nil, err
+	}
+	result, err := db.ExecContext(ctx, query, args...)
+	return result, err
+}
+
+type orderDefaultInsertHooker interface {
+	DefaultInsertHook(orderInsertSQL) (orderInsertSQL, error)
+}
+
+type orderInsertSQLToSqler interface {
+	orderInsertSQLToSql() (string, []interface{}, error)
+}
+
+type orderInsertOnDuplicateKeyUpdateSQL struct {
+	insertSQL               orderInsertSQLToSqler
+	onDuplicateKeyUpdateMap sqlla.SetMap
+}
+
+func (q orderInsertOnDuplicateKeyUpdateSQL) ValueOnUpdateID(v int64) orderInsertOnDuplicateKeyUpdateSQL {
+	q.onDuplicateKeyUpdateMap["`id`"] = v
+	return q
+}
+
+func (q orderInsertOnDuplicateKeyUpdateSQL) RawValueOnUpdateID(v sqlla.SetMapRawValue) orderInsertOnDuplicateKeyUpdateSQL {
+	q.onDuplicateKeyUpdateMap["`id`"] = v
+	return q
+}
+
+func (q orderInsertOnDuplicateKeyUpdateSQL) SameOnUpdateID() orderInsertOnDuplicateKeyUpdateSQL {
+	q.onDuplicateKeyUpdateMap["`id`"] = sqlla.SetMapRawValue("VALUES(`id`)")
+	return q
+}
+
+func (q orderInsertOnDuplicateKeyUpdateSQL) ValueOnUpdateChairID(v int64) orderInsertOnDuplicateKeyUpdateSQL {
+	q.onDuplicateKeyUpdateMap["`chair_id`"] = v
+	return q
+}
+
+func (q orderInsertOnDuplicateKeyUpdateSQL) RawValueOnUpdateChairID(v sqlla.SetMapRawValue) orderInsertOnDuplicateKeyUpdateSQL {
+	q.onDuplicateKeyUpdateMap["`chair_id`"] = v
+	return q
+}
+
+func (q orderInsertOnDuplicateKeyUpdateSQL) SameOnUpdateChairID() orderInsertOnDuplicateKeyUpdateSQL {
+	q.onDuplicateKeyUpdateMap["`chair_id`"] = sqlla.SetMapRawValue("VALUES(`chair_id`)")
+	return q
+}
+
+func (q orderInsertOnDuplicateKeyUpdateSQL) ValueOnUpdateEmail(v string) orderInsertOnDuplicateKeyUpdateSQL {
+	q.onDuplicateKeyUpdateMap["`email`"] = v
+	return q
+}
+
+func (q orderInsertOnDuplicateKeyUpdateSQL) RawValueOnUpdateEmail(v sqlla.SetMapRawValue) orderInsertOnDuplicateKeyUpdateSQL {
+	q.onDuplicateKeyUpdateMap["`email`"] = v
+	return q
+}
+
+func (q orderInsertOnDuplicateKeyUpdateSQL) SameOnUpdateEmail() orderInsertOnDuplicateKeyUpdateSQL {
+	q.onDuplicateKeyUpdateMap["`email`"] = sqlla.SetMapRawValue("VALUES(`email`)")
+	return q
+}
+
+func (q orderInsertOnDuplicateKeyUpdateSQL) ValueOnUpdatePrice(v int64) orderInsertOnDuplicateKeyUpdateSQL {
+	q.onDuplicateKeyUpdateMap["`price`"] = v
+	return q
+}
+
+func (q orderInsertOnDuplicateKeyUpdateSQL) RawValueOnUpdatePrice(v sqlla.SetMapRawValue) orderInsertOnDuplicateKeyUpdateSQL {
+	q.onDuplicateKeyUpdateMap["`price`"] = v
+	return q
+}
+
+func (q orderInsertOnDuplicateKeyUpdateSQL) SameOnUpdatePrice() orderInsertOnDuplicateKeyUpdateSQL {
+	q.onDuplicateKeyUpdateMap["`price`"] = sqlla.SetMapRawValue("VALUES(`price`)")
+	return q
+}
+
+func (q orderInsertOnDuplicateKeyUpdateSQL) ValueOnUpdateQuantity(v int64) orderInsertOnDuplicateKeyUpdateSQL {
+	q.onDuplicateKeyUpdateMap["`quantity`"] = v
+	return q
+}
+
+func (q orderInsertOnDuplicateKeyUpdateSQL) RawValueOnUpdateQuantity(v sqlla.SetMapRawValue) orderInsertOnDuplicateKeyUpdateSQL {
+	q.onDuplicateKeyUpdateMap["`quantity`"] = v
+	return q
+}
+
+func (q orderInsertOnDuplicateKeyUpdateSQL) SameOnUpdateQuantity() orderInsertOnDuplicateKeyUpdateSQL {
+	q.onDuplicateKeyUpdateMap["`quantity`"] = sqlla.SetMapRawValue("VALUES(`quantity`)")
+	return q
+}
+
+func (q orderInsertOnDuplicateKeyUpdateSQL) ValueOnUpdateCreatedAt(v time.Time) orderInsertOnDuplicateKeyUpdateSQL {
+	q.onDuplicateKeyUpdateMap["`created_at`"] = v
+	return q
+}
+
+func (q orderInsertOnDuplicateKeyUpdateSQL) RawValueOnUpdateCreatedAt(v sqlla.SetMapRawValue) orderInsertOnDuplicateKeyUpdateSQL {
+	q.onDuplicateKeyUpdateMap["`created_at`"] = v
+	return q
+}
+
+func (q orderInsertOnDuplicateKeyUpdateSQL) SameOnUpdateCreatedAt() orderInsertOnDuplicateKeyUpdateSQL {
+	q.onDuplicateKeyUpdateMap["`created_at`"] = sqlla.SetMapRawValue("VALUES(`created_at`)")
+	return q
+}
+
+func (q orderInsertOnDuplicateKeyUpdateSQL) ToSql() (string, []interface{}, error) {
+	var err error
+	var s interface{} = Order{}
+	if t, ok := s.(orderDefaultInsertOnDuplicateKeyUpdateHooker); ok {
+		q, err = t.DefaultInsertOnDuplicateKeyUpdateHook(q)
+		if err != nil {
+			return "", []interface{}{}, err
+		}
+	}
+
+	query, vs, err := q.insertSQL.orderInsertSQLToSql()
+	if err != nil {
+		return "", []interface{}{}, err
+	}
+
+	os, ovs, err := q.onDuplicateKeyUpdateMap.ToUpdateSql()
+	if err != nil {
+		return "", []interface{}{}, err
+	}
+	query += " ON DUPLICATE KEY UPDATE" + os
+	vs = append(vs, ovs...)
+
+	return query + ";", vs, nil
+}
+
+func (q orderInsertOnDuplicateKeyUpdateSQL) ExecContext(ctx context.Context, db sqlla.DB) (sql.Result, error) {
+	query, args, err := q.ToSql()
+	if err != nil {
+
+		return nil, err
+	}
+	result, err := db.ExecContext(ctx, query, args...)
+	return result, err
+}
+
+type orderDefaultInsertOnDuplicateKeyUpdateHooker interface {
+	DefaultInsertOnDuplicateKeyUpdateHook(orderInsertOnDuplicateKeyUpdateSQL) (orderInsertOnDuplicateKeyUpdateSQL, error)
+}
+
+type orderBulkInsertSQL struct {
+	insertSQLs []orderInsertSQL
+}
+
+func (q orderSQL) BulkInsert() *orderBulkInsertSQL {
+	return &orderBulkInsertSQL{
+		insertSQLs: []orderInsertSQL{},
+	}
+}
+
+func (q *orderBulkInsertSQL) Append(iqs ...orderInsertSQL) {
+	q.insertSQLs = append(q.insertSQLs, iqs...)
+}
+
+func (q *orderBulkInsertSQL) orderInsertSQLToSql() (string, []interface{}, error) {
+	if len(q.insertSQLs) == 0 {
+		return "", []interface{}{}, fmt.Errorf("sqlla: This orderBulkInsertSQL's InsertSQL was empty")
+	}
+	iqs := make([]orderInsertSQL, len(q.insertSQLs))
+	copy(iqs, q.insertSQLs)
+
+	var s interface{} = Order{}
+	if t, ok := s.(orderDefaultInsertHooker); ok {
+		for i, iq := range iqs {
+			var err error
+			iq, err = t.DefaultInsertHook(iq)
+			if err != nil {
+				return "", []interface{}{}, err
+			}
+			iqs[i] = iq
+		}
+	}
+
+	sms := make(sqlla.SetMaps, 0, len(q.insertSQLs))
+	for _, iq := range q.insertSQLs {
+		sms = append(sms, iq.setMap)
+	}
+
+	query, vs, err := sms.ToInsertSql()
+	if err != nil {
+		return "", []interface{}{}, err
+	}
+
+	return "INSERT INTO `orders` " + query, vs, nil
+}
+
+func (q *orderBulkInsertSQL) ToSql() (string, []interface{}, error) {
+	query, vs, err := q.orderInsertSQLToSql()
+	if err != nil {
+		return "", []interface{}{}, err
+	}
+	return query + ";", vs, nil
+}
+
+func (q *orderBulkInsertSQL) OnDuplicateKeyUpdate() orderInsertOnDuplicateKeyUpdateSQL {
+	return orderInsertOnDuplicateKeyUpdateSQL{
+		insertSQL:               q,
+		onDuplicateKeyUpdateMap: sqlla.SetMap{},
+	}
+}
+
+func (q *orderBulkInsertSQL) ExecContext(ctx context.Context, db sqlla.DB) (sql.Result, error) {
+	query, args, err := q.ToSql()
+	if err != nil {
+		return nil, err
+	}
+	result, err := db.ExecContext(ctx, query, args...)
+	return result, err
+}
+
+type orderDeleteSQL struct {
+	orderSQL
+}
+
+func (q orderSQL) Delete() orderDeleteSQL {
+	return orderDeleteSQL{
+		q,
+	}
+}
+
+func (q orderDeleteSQL) ID(v int64, exprs ...sqlla.Operator) orderDeleteSQL {
+	var op sqlla.Operator
+	if len(exprs) == 0 {
+		op = sqlla.OpEqual
+	} else {
+		op = exprs[0]
+	}
+	where := sqlla.ExprInt64{Value: v, Op: op, Column: "`id`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q orderDeleteSQL) IDIn(vs ...int64) orderDeleteSQL {
+	where := sqlla.ExprMultiInt64{Values: vs, Op: sqlla.MakeInOperator(len(vs)), Column: "`id`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q orderDeleteSQL) ChairID(v int64, exprs ...sqlla.Operator) orderDeleteSQL {
+	var op sqlla.Operator
+	if len(exprs) == 0 {
+		op = sqlla.OpEqual
+	} else {
+		op = exprs[0]
+	}
+	where := sqlla.ExprInt64{Value: v, Op: op, Column: "`chair_id`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q orderDeleteSQL) ChairIDIn(vs ...int64) orderDeleteSQL {
+	where := sqlla.ExprMultiInt64{Values: vs, Op: sqlla.MakeInOperator(len(vs)), Column: "`chair_id`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q orderDeleteSQL) Email(v string, exprs ...sqlla.Operator) orderDeleteSQL {
+	var op sqlla.Operator
+	if len(exprs) == 0 {
+		op = sqlla.OpEqual
+	} else {
+		op = exprs[0]
+	}
+	where := sqlla.ExprString{Value: v, Op: op, Column: "`email`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q orderDeleteSQL) EmailIn(vs ...string) orderDeleteSQL {
+	where := sqlla.ExprMultiString{Values: vs, Op: sqlla.MakeInOperator(len(vs)), Column: "`email`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q orderDeleteSQL) Price(v int64, exprs ...sqlla.Operator) orderDeleteSQL {
+	var op sqlla.Operator
+	if len(exprs) == 0 {
+		op = sqlla.OpEqual
+	} else {
+		op = exprs[0]
+	}
+	where := sqlla.ExprInt64{Value: v, Op: op, Column: "`price`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q orderDeleteSQL) PriceIn(vs ...int64) orderDeleteSQL {
+	where := sqlla.ExprMultiInt64{Values: vs, Op: sqlla.MakeInOperator(len(vs)), Column: "`price`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q orderDeleteSQL) Quantity(v int64, exprs ...sqlla.Operator) orderDeleteSQL {
+	var op sqlla.Operator
+	if len(exprs) == 0 {
+		op = sqlla.OpEqual
+	} else {
+		op = exprs[0]
+	}
+	where := sqlla.ExprInt64{Value: v, Op: op, Column: "`quantity`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q orderDeleteSQL) QuantityIn(vs ...int64) orderDeleteSQL {
+	where := sqlla.ExprMultiInt64{Values: vs, Op: sqlla.MakeInOperator(len(vs)), Column: "`quantity`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q orderDeleteSQL) CreatedAt(v time.Time, exprs ...sqlla.Operator) orderDeleteSQL {
+	var op sqlla.Operator
+	if len(exprs) == 0 {
+		op = sqlla.OpEqual
+	} else {
+		op = exprs[0]
+	}
+	where := sqlla.ExprTime{Value: v, Op: op, Column: "`created_at`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q orderDeleteSQL) CreatedAtIn(vs ...time.Time) orderDeleteSQL {
+	where := sqlla.ExprMultiTime{Values: vs, Op: sqlla.MakeInOperator(len(vs)), Column: "`created_at`"}
+	q.where = append(q.where, where)
+	return q
+}
+
+func (q orderDeleteSQL) ToSql() (string, []interface{}, error) {
+	wheres, vs, err := q.where.ToSql()
+	if err != nil {
+		return "", nil, err
+	}
+
+	query := "DELETE FROM orders"
+	if wheres != "" {
+		query += " WHERE" + wheres
+	}
+
+	return query + ";", vs, nil
+}
+
+func (q orderDeleteSQL) Exec(db sqlla.DB) (sql.Result, error) {
+	query, args, err := q.ToSql()
+	if err != nil {
+		return nil, err
+	}
+	return db.Exec(query, args...)
+}
+
+func (q orderDeleteSQL) ExecContext(ctx context.Context, db sqlla.DB) (sql.Result, error) {
+	query, args, err := q.ToSql()
+	if err != nil {
+		return nil, err
+	}
+	return db.ExecContext(ctx, query, args...)
+}
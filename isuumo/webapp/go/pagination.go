@@ -0,0 +1,61 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	DefaultPerPage = Limit
+	MaxPerPage     = 100
+	MaxPage        = 10000
+)
+
+// Pagination page/perPageクエリパラメータを範囲チェックしたもの
+type Pagination struct {
+	Page    int
+	PerPage int
+}
+
+func (p Pagination) Offset() int {
+	return p.Page * p.PerPage
+}
+
+// paginationParam page/perPageを読む。一覧を返すハンドラはすべてこれを使う
+func paginationParam(c echo.Context) (Pagination, error) {
+	page, err := intRangeQueryParam(c, "page", ErrCodeInvalidPage, 0, 0, MaxPage)
+	if err != nil {
+		return Pagination{}, err
+	}
+	perPage, err := perPageParam(c)
+	if err != nil {
+		return Pagination{}, err
+	}
+	return Pagination{Page: page, PerPage: perPage}, nil
+}
+
+// perPageParam cursorで続きを取る一覧のようにpageを使わないハンドラ向けにperPageだけを読む
+func perPageParam(c echo.Context) (int, error) {
+	return intRangeQueryParam(c, "perPage", ErrCodeInvalidPerPage, DefaultPerPage, 1, MaxPerPage)
+}
+
+// intRangeQueryParam 整数のクエリパラメータを読む。指定がなければdefaultValueを返し、min以上max以下でなければ400を返す
+func intRangeQueryParam(c echo.Context, name string, code ErrorCode, defaultValue, min, max int) (int, error) {
+	s := c.QueryParam(name)
+	if s == "" {
+		return defaultValue, nil
+	}
+	v, err := strconv.Atoi(s)
+	if err != nil {
+		c.Logger().Infof("Invalid format %s parameter : %v", name, err)
+		return 0, newAPIError(http.StatusBadRequest, code, fmt.Sprintf("%s must be an integer", name))
+	}
+	if v < min || max < v {
+		c.Logger().Infof("Out of range %s parameter : %v", name, v)
+		return 0, newAPIError(http.StatusBadRequest, code, fmt.Sprintf("%s must be between %d and %d", name, min, max))
+	}
+	return v, nil
+}
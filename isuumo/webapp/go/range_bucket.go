@@ -0,0 +1,129 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// rangeBucketColumn 0_Schema.sqlで生成列として定義している *_range 列。
+// バケットの境界は検索条件のfixtureのRangesと一致していなければならない
+type rangeBucketColumn struct {
+	db     *sqlx.DB
+	table  string
+	source string
+	cond   RangeCondition
+}
+
+func (rc rangeBucketColumn) column() string {
+	return rc.source + "_range"
+}
+
+func (rc rangeBucketColumn) indexName() string {
+	return rc.table + "_" + rc.column() + "_popularity_id_index"
+}
+
+func rangeBucketColumns(conds *SearchConditions) []rangeBucketColumn {
+	return []rangeBucketColumn{
+		{db: chairDB, table: "chair", source: "price", cond: conds.Chair.Price},
+		{db: chairDB, table: "chair", source: "height", cond: conds.Chair.Height},
+		{db: chairDB, table: "chair", source: "width", cond: conds.Chair.Width},
+		{db: chairDB, table: "chair", source: "depth", cond: conds.Chair.Depth},
+		{db: estateDB, table: "estate", source: "rent", cond: conds.Estate.Rent},
+		{db: estateDB, table: "estate", source: "door_height", cond: conds.Estate.DoorHeight},
+		{db: estateDB, table: "estate", source: "door_width", cond: conds.Estate.DoorWidth},
+	}
+}
+
+// rangeCaseExpression Rangesから生成列のCASE式を作る。minとmaxの-1は上限または下限なしを表す
+func rangeCaseExpression(source string, cond RangeCondition) string {
+	var sb strings.Builder
+	sb.WriteString("CASE")
+	for _, r := range cond.Ranges {
+		preds := make([]string, 0, 2)
+		if r.Min != -1 {
+			preds = append(preds, fmt.Sprintf("%d <= %s", r.Min, source))
+		}
+		if r.Max != -1 {
+			preds = append(preds, fmt.Sprintf("%s < %d", source, r.Max))
+		}
+		if len(preds) == 0 {
+			preds = append(preds, "TRUE")
+		}
+		fmt.Fprintf(&sb, " WHEN %s THEN %d", strings.Join(preds, " AND "), r.ID)
+	}
+	sb.WriteString(" END")
+	return sb.String()
+}
+
+// verifyRangeBuckets DBの生成列の式に各Rangeの境界値を入れて、fixtureと同じバケットになるか確かめる
+func verifyRangeBuckets(ctx context.Context, conds *SearchConditions) error {
+	for _, rc := range rangeBucketColumns(conds) {
+		for i, r := range rc.cond.Ranges {
+			if r.ID != int64(i) {
+				return fmt.Errorf("%s.%s: range id %d is at index %d", rc.table, rc.column(), r.ID, i)
+			}
+		}
+
+		var expr string
+		query := `SELECT pg_get_expr(d.adbin, d.adrelid) FROM pg_attribute a JOIN pg_attrdef d ON d.adrelid = a.attrelid AND d.adnum = a.attnum WHERE a.attrelid = ?::regclass AND a.attname = ? AND a.attgenerated = 's'`
+		if err := rc.db.GetContext(ctx, &expr, query, rc.table, rc.column()); err != nil {
+			return fmt.Errorf("%s.%s: failed to get generated column expression: %w", rc.table, rc.column(), err)
+		}
+
+		probe := fmt.Sprintf("SELECT %s FROM (SELECT ?::integer AS %s) AS t", expr, rc.source)
+		for _, r := range rc.cond.Ranges {
+			values := make([]int64, 0, 2)
+			if r.Min != -1 {
+				values = append(values, r.Min)
+			}
+			if r.Max != -1 {
+				values = append(values, r.Max-1)
+			}
+			if len(values) == 0 {
+				values = append(values, 0)
+			}
+			for _, v := range values {
+				var bucket *int64
+				if err := rc.db.GetContext(ctx, &bucket, probe, v); err != nil {
+					return fmt.Errorf("%s.%s: failed to evaluate generated column expression: %w", rc.table, rc.column(), err)
+				}
+				if bucket == nil || *bucket != r.ID {
+					got := "NULL"
+					if bucket != nil {
+						got = fmt.Sprint(*bucket)
+					}
+					return fmt.Errorf("%s.%s: %s = %d is in range %d but the column gives %s", rc.table, rc.column(), rc.source, v, r.ID, got)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// rebucketRanges *_range列をfixtureのRangesから作ったCASE式で作り直す
+func rebucketRanges(ctx context.Context, conds *SearchConditions) error {
+	for _, rc := range rangeBucketColumns(conds) {
+		tx, err := rc.db.BeginTxx(ctx, nil)
+		if err != nil {
+			return err
+		}
+		stmts := []string{
+			fmt.Sprintf("ALTER TABLE %s DROP COLUMN IF EXISTS %s", rc.table, rc.column()),
+			fmt.Sprintf("ALTER TABLE %s ADD COLUMN %s int GENERATED ALWAYS AS (%s) STORED", rc.table, rc.column(), rangeCaseExpression(rc.source, rc.cond)),
+			fmt.Sprintf("CREATE INDEX %s ON %s (%s asc, popularity desc, id asc)", rc.indexName(), rc.table, rc.column()),
+		}
+		for _, stmt := range stmts {
+			if _, err := tx.ExecContext(ctx, stmt); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("%s.%s: %w", rc.table, rc.column(), err)
+			}
+		}
+		if err := tx.Commit(); err != nil {
+			return fmt.Errorf("%s.%s: %w", rc.table, rc.column(), err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,104 @@
+package main
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"slices"
+)
+
+// RecordHeader CSVのヘッダー行。列名をChair/Estateのdbタグに対応させ、列の順番によらずに読む
+type RecordHeader struct {
+	typ reflect.Type
+	// fields CSVの列ごとに入れる構造体のフィールドのindex
+	fields []int
+	// columns bulkLoaderの列ごとに値を取り出す構造体のフィールドのindex。-1ならdefaultsの値を使う
+	columns []int
+	loader  bulkLoader
+}
+
+// readRecordHeader rの1行目をヘッダーとして読む。足りない列、知らない列、入稿では書けない列があれば400を返す
+func readRecordHeader(r *csv.Reader, typ reflect.Type, l bulkLoader) (*RecordHeader, error) {
+	header, err := r.Read()
+	if err != nil {
+		return nil, newAPIError(http.StatusBadRequest, ErrCodeInvalidUpload, fmt.Sprintf("failed to read csv header: %v", err))
+	}
+
+	tags := make(map[string]int, typ.NumField())
+	for i := 0; i < typ.NumField(); i++ {
+		if tag := typ.Field(i).Tag.Get("db"); tag != "" {
+			tags[tag] = i
+		}
+	}
+
+	h := &RecordHeader{typ: typ, fields: make([]int, 0, len(header)), loader: l}
+	var errs []FieldError
+	for j, name := range header {
+		i, ok := tags[name]
+		switch {
+		case !ok:
+			errs = append(errs, FieldError{Field: name, Message: "is unknown"})
+		case !slices.Contains(l.columns, name) || l.defaults[name] != nil:
+			errs = append(errs, FieldError{Field: name, Message: "cannot be uploaded"})
+		case slices.Contains(header[:j], name):
+			errs = append(errs, FieldError{Field: name, Message: "is duplicated"})
+		}
+		h.fields = append(h.fields, i)
+	}
+	for _, col := range l.columns {
+		switch {
+		case slices.Contains(header, col):
+			h.columns = append(h.columns, tags[col])
+		case l.defaults[col] != nil:
+			h.columns = append(h.columns, -1)
+		default:
+			errs = append(errs, FieldError{Field: col, Message: "is missing"})
+		}
+	}
+	if len(errs) > 0 {
+		return nil, &APIError{
+			Status:  http.StatusBadRequest,
+			Code:    ErrCodeInvalidUpload,
+			Message: "csv header does not match the columns",
+			Errors:  errs,
+		}
+	}
+	return h, nil
+}
+
+// csvHeaderRowSource 1行目をヘッダーとして読み、残りの行を列名で読む
+func csvHeaderRowSource(f io.Reader, typ reflect.Type, l bulkLoader) (func() (uploadRow, error), error) {
+	r := newUploadCSVReader(f)
+	h, err := readRecordHeader(r, typ, l)
+	if err != nil {
+		return nil, err
+	}
+	return csvRowSource(r, h.mapRecord), nil
+}
+
+// mapRecord csvRowSourceに渡す。列をヘッダーの順に構造体のフィールドへ読み、bulkLoaderの列の順の値にする
+func (h *RecordHeader) mapRecord(rm *RecordMapper) uploadRow {
+	v := reflect.New(h.typ).Elem()
+	for _, i := range h.fields {
+		switch f := v.Field(i); f.Kind() {
+		case reflect.Int64:
+			f.SetInt(int64(rm.NextInt()))
+		case reflect.Float64:
+			f.SetFloat(rm.NextFloat())
+		default:
+			f.SetString(rm.NextString())
+		}
+	}
+
+	values := make([]interface{}, 0, len(h.columns))
+	for j, i := range h.columns {
+		if i < 0 {
+			values = append(values, h.loader.defaults[h.loader.columns[j]])
+			continue
+		}
+		values = append(values, v.Field(i).Interface())
+	}
+	return uploadRow{ID: v.FieldByName("ID").Int(), Values: values}
+}
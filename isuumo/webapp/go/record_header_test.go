@@ -0,0 +1,86 @@
+package main
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestReadRecordHeader(t *testing.T) {
+	const estateHeader = "id,name,description,thumbnail,address,latitude,longitude,rent,door_height,door_width,features,popularity"
+	tests := []struct {
+		name       string
+		header     string
+		wantErrors []FieldError
+	}{
+		{name: "all columns", header: estateHeader},
+		{name: "reordered", header: "popularity,features,door_width,door_height,rent,longitude,latitude,address,thumbnail,description,name,id"},
+		{
+			name:       "unknown column",
+			header:     estateHeader + ",foo",
+			wantErrors: []FieldError{{Field: "foo", Message: "is unknown"}},
+		},
+		{
+			name:   "columns that cannot be uploaded",
+			header: estateHeader + ",status,version",
+			wantErrors: []FieldError{
+				{Field: "status", Message: "cannot be uploaded"},
+				{Field: "version", Message: "cannot be uploaded"},
+			},
+		},
+		{
+			name:       "duplicated column",
+			header:     estateHeader + ",name",
+			wantErrors: []FieldError{{Field: "name", Message: "is duplicated"}},
+		},
+		{
+			name:       "missing column",
+			header:     strings.TrimSuffix(estateHeader, ",popularity"),
+			wantErrors: []FieldError{{Field: "popularity", Message: "is missing"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := readRecordHeader(newUploadCSVReader(strings.NewReader(tt.header+"\n")), reflect.TypeOf(Estate{}), estateLoader)
+			if tt.wantErrors == nil {
+				if err != nil {
+					t.Fatalf("readRecordHeader() error = %v", err)
+				}
+				return
+			}
+			var ae *APIError
+			if !errors.As(err, &ae) || ae.Code != ErrCodeInvalidUpload {
+				t.Fatalf("readRecordHeader() error = %v, want code %s", err, ErrCodeInvalidUpload)
+			}
+			if !reflect.DeepEqual(ae.Errors, tt.wantErrors) {
+				t.Errorf("readRecordHeader() errors = %+v, want %+v", ae.Errors, tt.wantErrors)
+			}
+		})
+	}
+}
+
+func TestCSVHeaderRowSource(t *testing.T) {
+	in := "price,kind,id,name,description,thumbnail,height,width,depth,color,features,popularity,stock\n" +
+		"15000,エルゴノミクス,1,椅子,説明,t.png,80,50,45,黒,\"肘掛け,キャスター\",10,3\n" +
+		"abc,エルゴノミクス,2,椅子,説明,t.png,80,50,45,黒,,10,3\n"
+	next, err := csvHeaderRowSource(strings.NewReader(in), reflect.TypeOf(Chair{}), chairLoader)
+	if err != nil {
+		t.Fatalf("csvHeaderRowSource() error = %v", err)
+	}
+
+	row, err := next()
+	if err != nil {
+		t.Fatalf("next() error = %v", err)
+	}
+	want := []interface{}{int64(1), "椅子", "説明", "t.png", int64(15000), int64(80), int64(50), int64(45), "黒", "肘掛け,キャスター", "エルゴノミクス", int64(10), int64(3), StatusActive}
+	if row.Line != 2 || row.ID != 1 || !reflect.DeepEqual(row.Values, want) {
+		t.Errorf("next() = %+v, want line 2 id 1 values %v", row, want)
+	}
+
+	_, err = next()
+	var re *uploadRowError
+	if !errors.As(err, &re) || re.Line != 3 {
+		t.Errorf("next() error = %v, want uploadRowError at line 3", err)
+	}
+}
@@ -0,0 +1,118 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+// searchSort sortクエリパラメータで選べる並び順。columnが空ならidの降順だけで並べる。
+// どれもidで順序を確定させ、0_Schema.sqlに同じ並びのインデックスを置いている
+type searchSort[T any] struct {
+	name   string
+	column string
+	desc   bool
+	key    func(T) int64
+	// q relevanceのときの検索語。類似度は行に持たないのでcursorは作れず、pageで続きを取る
+	q string
+}
+
+func relevanceSort[T any](q string) searchSort[T] {
+	return searchSort[T]{name: "relevance", q: q}
+}
+
+var chairSorts = []searchSort[Chair]{
+	{name: "popularity", column: "popularity", desc: true, key: func(c Chair) int64 { return c.Popularity }},
+	{name: "price", column: "price", key: func(c Chair) int64 { return c.Price }},
+	{name: "-price", column: "price", desc: true, key: func(c Chair) int64 { return c.Price }},
+	{name: "newest"},
+}
+
+var estateSorts = []searchSort[Estate]{
+	{name: "popularity", column: "popularity", desc: true, key: func(e Estate) int64 { return e.Popularity }},
+	{name: "rent", column: "rent", key: func(e Estate) int64 { return e.Rent }},
+	{name: "-rent", column: "rent", desc: true, key: func(e Estate) int64 { return e.Rent }},
+	{name: "newest"},
+}
+
+// sortParam sortクエリパラメータを読む。指定がなければqがあるときはrelevance、ないときは先頭の並び順を使う
+func sortParam[T any](c echo.Context, sorts []searchSort[T], q string) (searchSort[T], error) {
+	name := c.QueryParam("sort")
+	if (name == "" && q != "") || name == "relevance" {
+		if q == "" {
+			c.Logger().Infof("sort=relevance without q parameter")
+			return searchSort[T]{}, newAPIError(http.StatusBadRequest, ErrCodeInvalidSort, "sort relevance requires q")
+		}
+		return relevanceSort[T](q), nil
+	}
+	if name == "" {
+		return sorts[0], nil
+	}
+	s, ok := sortByName(sorts, name)
+	if !ok {
+		c.Logger().Infof("Invalid sort parameter : %v", name)
+		return s, newAPIError(http.StatusBadRequest, ErrCodeInvalidSort, fmt.Sprintf("sort has an unknown value : %q", name))
+	}
+	return s, nil
+}
+
+func sortByName[T any](sorts []searchSort[T], name string) (searchSort[T], bool) {
+	for _, s := range sorts {
+		if s.name == name {
+			return s, true
+		}
+	}
+	return searchSort[T]{}, false
+}
+
+func (s searchSort[T]) orderBy() (string, []interface{}) {
+	switch {
+	case s.q != "":
+		return " ORDER BY word_similarity(?, " + textSearchColumn + ") DESC, popularity DESC, id ASC", []interface{}{s.q}
+	case s.column == "":
+		return " ORDER BY id DESC", nil
+	case s.desc:
+		return fmt.Sprintf(" ORDER BY %s DESC, id ASC", s.column), nil
+	default:
+		return fmt.Sprintf(" ORDER BY %s ASC, id ASC", s.column), nil
+	}
+}
+
+// cursorParam この並び順で続きを取るcursorを読む
+func (s searchSort[T]) cursorParam(c echo.Context) (*searchCursor, error) {
+	if s.q != "" {
+		if c.QueryParam("cursor") != "" {
+			c.Logger().Infof("cursor with sort=relevance")
+			return nil, newAPIError(http.StatusBadRequest, ErrCodeInvalidCursor, "cursor is not supported for sort relevance, use page instead")
+		}
+		return nil, nil
+	}
+	return searchCursorParam(c, s.name)
+}
+
+// cursorCondition cursorより後ろの行を絞り込む条件を返す。
+// 降順のキーとidの昇順を組み合わせるときは行値比較が使えないので、先頭の比較でインデックスの範囲を絞る
+func (s searchSort[T]) cursorCondition(sc *searchCursor) (string, []interface{}) {
+	switch {
+	case s.column == "":
+		return "id < ?", []interface{}{sc.ID}
+	case s.desc:
+		return fmt.Sprintf("%s <= ? AND (%s < ? OR id > ?)", s.column, s.column),
+			[]interface{}{sc.Key, sc.Key, sc.ID}
+	default:
+		return fmt.Sprintf("(%s, id) > (?, ?)", s.column), []interface{}{sc.Key, sc.ID}
+	}
+}
+
+// nextCursor rowの次から続きを取るためのcursorを返す
+func (s searchSort[T]) nextCursor(row T, id int64) string {
+	if s.q != "" {
+		return ""
+	}
+	sc := searchCursor{Sort: s.name, ID: id}
+	if s.key != nil {
+		sc.Key = s.key(row)
+	}
+	return encodeSearchCursor(sc)
+}
@@ -0,0 +1,44 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/labstack/echo/v4"
+)
+
+// textSearchColumn 全文検索の対象。0_Schema.sqlのpg_trgmのインデックスと同じ式でないとインデックスが使われない
+const textSearchColumn = "(name || ' ' || description)"
+
+const MaxTextSearchLength = 100
+
+// textSearchParam qクエリパラメータを読む。前後の空白は取り除く
+func textSearchParam(c echo.Context) (string, error) {
+	q := strings.TrimSpace(c.QueryParam("q"))
+	if utf8.RuneCountInString(q) > MaxTextSearchLength {
+		c.Logger().Infof("Too long q parameter : %v", q)
+		return "", newAPIError(http.StatusBadRequest, ErrCodeInvalidQuery, fmt.Sprintf("q must be at most %d characters", MaxTextSearchLength))
+	}
+	return q, nil
+}
+
+// textSearchConditions 空白で区切った語をすべてnameかdescriptionに含むものに絞る条件を返す。
+// 日本語は分かち書きしないので、トライグラムのインデックスで部分一致を探す
+func textSearchConditions(q string) ([]string, []interface{}) {
+	terms := strings.Fields(q)
+	conditions := make([]string, 0, len(terms))
+	params := make([]interface{}, 0, len(terms))
+	for _, t := range terms {
+		conditions = append(conditions, textSearchColumn+" ILIKE ?")
+		params = append(params, "%"+escapeLike(t)+"%")
+	}
+	return conditions, params
+}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+func escapeLike(s string) string {
+	return likeEscaper.Replace(s)
+}
@@ -0,0 +1,528 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/labstack/echo/v4"
+)
+
+// PutChairRequest PUT chair/:idへのリクエストの形式。在庫は売り切れのリストと揃える必要があるのでadmin/chair/:id/stockで変える
+type PutChairRequest struct {
+	Name        string `json:"name" validate:"required,max=64"`
+	Description string `json:"description" validate:"required,max=4096"`
+	Thumbnail   string `json:"thumbnail" validate:"required,max=128"`
+	Price       int64  `json:"price" validate:"gte=0"`
+	Height      int64  `json:"height" validate:"gte=0"`
+	Width       int64  `json:"width" validate:"gte=0"`
+	Depth       int64  `json:"depth" validate:"gte=0"`
+	Color       string `json:"color" validate:"required,max=64"`
+	Features    string `json:"features" validate:"max=64"`
+	Kind        string `json:"kind" validate:"required,max=64"`
+	Popularity  int64  `json:"popularity" validate:"gte=0"`
+	Status      string `json:"status" validate:"omitempty,oneof=active hidden archived"`
+	Version     *int64 `json:"version" validate:"required,gte=0"`
+}
+
+// PatchChairRequest PATCH chair/:idへのリクエストの形式。指定した項目だけ変える
+type PatchChairRequest struct {
+	Name        *string `json:"name" validate:"omitempty,min=1,max=64"`
+	Description *string `json:"description" validate:"omitempty,min=1,max=4096"`
+	Thumbnail   *string `json:"thumbnail" validate:"omitempty,min=1,max=128"`
+	Price       *int64  `json:"price" validate:"omitempty,gte=0"`
+	Height      *int64  `json:"height" validate:"omitempty,gte=0"`
+	Width       *int64  `json:"width" validate:"omitempty,gte=0"`
+	Depth       *int64  `json:"depth" validate:"omitempty,gte=0"`
+	Color       *string `json:"color" validate:"omitempty,min=1,max=64"`
+	Features    *string `json:"features" validate:"omitempty,max=64"`
+	Kind        *string `json:"kind" validate:"omitempty,min=1,max=64"`
+	Popularity  *int64  `json:"popularity" validate:"omitempty,gte=0"`
+	Status      *string `json:"status" validate:"omitempty,oneof=active hidden archived"`
+	Version     *int64  `json:"version" validate:"required,gte=0"`
+}
+
+// PutEstateRequest PUT estate/:idへのリクエストの形式
+type PutEstateRequest struct {
+	Name        string  `json:"name" validate:"required,max=64"`
+	Description string  `json:"description" validate:"required,max=4096"`
+	Thumbnail   string  `json:"thumbnail" validate:"required,max=128"`
+	Address     string  `json:"address" validate:"required,max=128"`
+	Latitude    float64 `json:"latitude" validate:"gte=-90,lte=90"`
+	Longitude   float64 `json:"longitude" validate:"gte=-180,lte=180"`
+	Rent        int64   `json:"rent" validate:"gte=0"`
+	DoorHeight  int64   `json:"doorHeight" validate:"gte=0"`
+	DoorWidth   int64   `json:"doorWidth" validate:"gte=0"`
+	Features    string  `json:"features" validate:"max=64"`
+	Popularity  int64   `json:"popularity" validate:"gte=0"`
+	Status      string  `json:"status" validate:"omitempty,oneof=active hidden archived"`
+	Version     *int64  `json:"version" validate:"required,gte=0"`
+}
+
+// PatchEstateRequest PATCH estate/:idへのリクエストの形式。指定した項目だけ変える
+type PatchEstateRequest struct {
+	Name        *string  `json:"name" validate:"omitempty,min=1,max=64"`
+	Description *string  `json:"description" validate:"omitempty,min=1,max=4096"`
+	Thumbnail   *string  `json:"thumbnail" validate:"omitempty,min=1,max=128"`
+	Address     *string  `json:"address" validate:"omitempty,min=1,max=128"`
+	Latitude    *float64 `json:"latitude" validate:"omitempty,gte=-90,lte=90"`
+	Longitude   *float64 `json:"longitude" validate:"omitempty,gte=-180,lte=180"`
+	Rent        *int64   `json:"rent" validate:"omitempty,gte=0"`
+	DoorHeight  *int64   `json:"doorHeight" validate:"omitempty,gte=0"`
+	DoorWidth   *int64   `json:"doorWidth" validate:"omitempty,gte=0"`
+	Features    *string  `json:"features" validate:"omitempty,max=64"`
+	Popularity  *int64   `json:"popularity" validate:"omitempty,gte=0"`
+	Status      *string  `json:"status" validate:"omitempty,oneof=active hidden archived"`
+	Version     *int64   `json:"version" validate:"required,gte=0"`
+}
+
+// listField 検索条件の一覧にある値しか入れられない項目。一覧にない値を入れると検索で絞り込めなくなる
+type listField struct {
+	name string
+	// value nilならPATCHで指定されていないので確かめない
+	value *string
+	cond  ListCondition
+	// multi featuresのようにカンマ区切りで複数の値を持つ。空なら値なし
+	multi bool
+}
+
+// validateListFields fieldsの値が検索条件の一覧にあるかを確かめ、なければ項目ごとのエラーを返す
+func validateListFields(c echo.Context, fields ...listField) error {
+	var errs []FieldError
+	for _, f := range fields {
+		if f.value == nil {
+			continue
+		}
+		vs := []string{*f.value}
+		if f.multi {
+			if *f.value == "" {
+				continue
+			}
+			vs = strings.Split(*f.value, ",")
+		}
+		for _, v := range vs {
+			if !slices.Contains(f.cond.List, v) {
+				errs = append(errs, FieldError{Field: f.name, Message: fmt.Sprintf("has an unknown value : %q", v)})
+				break
+			}
+		}
+	}
+	if len(errs) > 0 {
+		c.Echo().Logger.Infof("unknown list values : %v", errs)
+		return &APIError{
+			Status:  http.StatusBadRequest,
+			Code:    ErrCodeValidationFailed,
+			Message: "validation failed",
+			Errors:  errs,
+		}
+	}
+	return nil
+}
+
+func putChair(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.Echo().Logger.Infof("Request parameter \"id\" parse error : %v", err)
+		return newAPIError(http.StatusBadRequest, ErrCodeInvalidID, "id must be an integer")
+	}
+	var req PutChairRequest
+	if err := bindAndValidate(c, &req); err != nil {
+		c.Echo().Logger.Infof("put chair failed : %v", err)
+		return newValidationError(err)
+	}
+	sc := currentSearchConditions().Chair
+	if err := validateListFields(c,
+		listField{name: "kind", value: &req.Kind, cond: sc.Kind},
+		listField{name: "color", value: &req.Color, cond: sc.Color},
+		listField{name: "features", value: &req.Features, cond: sc.Feature, multi: true},
+	); err != nil {
+		return err
+	}
+
+	q := NewChairSQL().Update().
+		SetName(req.Name).
+		SetDescription(req.Description).
+		SetThumbnail(req.Thumbnail).
+		SetPrice(req.Price).
+		SetHeight(req.Height).
+		SetWidth(req.Width).
+		SetDepth(req.Depth).
+		SetColor(req.Color).
+		SetFeatures(req.Features).
+		SetKind(req.Kind).
+		SetPopularity(req.Popularity)
+	// statusは省略されたら今の公開状態のまま
+	if req.Status != "" {
+		q = q.SetStatus(req.Status)
+	}
+	return updateChair(c, id, *req.Version, q)
+}
+
+func patchChair(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.Echo().Logger.Infof("Request parameter \"id\" parse error : %v", err)
+		return newAPIError(http.StatusBadRequest, ErrCodeInvalidID, "id must be an integer")
+	}
+	var req PatchChairRequest
+	if err := bindAndValidate(c, &req); err != nil {
+		c.Echo().Logger.Infof("patch chair failed : %v", err)
+		return newValidationError(err)
+	}
+	sc := currentSearchConditions().Chair
+	if err := validateListFields(c,
+		listField{name: "kind", value: req.Kind, cond: sc.Kind},
+		listField{name: "color", value: req.Color, cond: sc.Color},
+		listField{name: "features", value: req.Features, cond: sc.Feature, multi: true},
+	); err != nil {
+		return err
+	}
+
+	q := NewChairSQL().Update()
+	n := 0
+	if req.Name != nil {
+		q, n = q.SetName(*req.Name), n+1
+	}
+	if req.Description != nil {
+		q, n = q.SetDescription(*req.Description), n+1
+	}
+	if req.Thumbnail != nil {
+		q, n = q.SetThumbnail(*req.Thumbnail), n+1
+	}
+	if req.Price != nil {
+		q, n = q.SetPrice(*req.Price), n+1
+	}
+	if req.Height != nil {
+		q, n = q.SetHeight(*req.Height), n+1
+	}
+	if req.Width != nil {
+		q, n = q.SetWidth(*req.Width), n+1
+	}
+	if req.Depth != nil {
+		q, n = q.SetDepth(*req.Depth), n+1
+	}
+	if req.Color != nil {
+		q, n = q.SetColor(*req.Color), n+1
+	}
+	if req.Features != nil {
+		q, n = q.SetFeatures(*req.Features), n+1
+	}
+	if req.Kind != nil {
+		q, n = q.SetKind(*req.Kind), n+1
+	}
+	if req.Popularity != nil {
+		q, n = q.SetPopularity(*req.Popularity), n+1
+	}
+	if req.Status != nil {
+		q, n = q.SetStatus(*req.Status), n+1
+	}
+	if n == 0 {
+		return newAPIError(http.StatusBadRequest, ErrCodeBadRequest, "no fields to update")
+	}
+	return updateChair(c, id, *req.Version, q)
+}
+
+// updateChair versionが変わっていなければqで更新してversionを1増やし、更新後の椅子を返す
+func updateChair(c echo.Context, id, version int64, q chairUpdateSQL) error {
+	ctx := c.Request().Context()
+	tx, err := chairDB.BeginTxx(ctx, nil)
+	if err != nil {
+		return errInternal(fmt.Errorf("failed to begin tx : %w", err))
+	}
+	defer tx.Rollback()
+
+	res, err := q.SetVersion(version+1).WhereID(id).WhereVersion(version).ExecContext(ctx, tx)
+	if err != nil {
+		return errInternal(fmt.Errorf("chair update failed : %w", err))
+	}
+	if n, err := res.RowsAffected(); err != nil {
+		return errInternal(fmt.Errorf("chair update failed : %w", err))
+	} else if n == 0 {
+		return versionConflictError(ctx, tx, "chair", id, version, ErrCodeChairNotFound)
+	}
+
+	var chair Chair
+	if err := tx.GetContext(ctx, &chair, "SELECT * FROM chair WHERE id = ?", id); err != nil {
+		return errInternal(fmt.Errorf("failed to get the chair from id : %w", err))
+	}
+	if err := tx.Commit(); err != nil {
+		return errInternal(fmt.Errorf("failed to commit tx : %w", err))
+	}
+
+	// 価格が変わると安い順の位置も変わるので入れ直す
+	lowPricedChairs.remove(id)
+	lowPricedChairs.add([]Chair{chair})
+	if err := purgeNginxCache(fmt.Sprintf("/api/chair/%d", id)); err != nil {
+		c.Echo().Logger.Errorf("failed to purge nginx cache, id: %v : %v", id, err)
+	}
+
+	return c.JSON(http.StatusOK, chair)
+}
+
+// deleteChair 椅子をarchivedにして見えなくする。hard=trueなら行ごと消す
+func deleteChair(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.Echo().Logger.Infof("Request parameter \"id\" parse error : %v", err)
+		return newAPIError(http.StatusBadRequest, ErrCodeInvalidID, "id must be an integer")
+	}
+	version, err := versionParam(c)
+	if err != nil {
+		return err
+	}
+	hard, err := boolQueryParam(c, "hard", false)
+	if err != nil {
+		return err
+	}
+
+	ctx := c.Request().Context()
+	tx, err := chairDB.BeginTxx(ctx, nil)
+	if err != nil {
+		return errInternal(fmt.Errorf("failed to begin tx : %w", err))
+	}
+	defer tx.Rollback()
+
+	var res sql.Result
+	if hard {
+		res, err = NewChairSQL().Delete().ID(id).Version(version).ExecContext(ctx, tx)
+	} else {
+		res, err = NewChairSQL().Update().SetStatus(StatusArchived).SetVersion(version+1).WhereID(id).WhereVersion(version).ExecContext(ctx, tx)
+	}
+	if err != nil {
+		return errInternal(fmt.Errorf("chair delete failed : %w", err))
+	}
+	if n, err := res.RowsAffected(); err != nil {
+		return errInternal(fmt.Errorf("chair delete failed : %w", err))
+	} else if n == 0 {
+		return versionConflictError(ctx, tx, "chair", id, version, ErrCodeChairNotFound)
+	}
+	if err := tx.Commit(); err != nil {
+		return errInternal(fmt.Errorf("failed to commit tx : %w", err))
+	}
+
+	lowPricedChairs.remove(id)
+	if hard {
+		if err := rdb.SRem(ctx, soldOutChairKey, id).Err(); err != nil {
+			c.Echo().Logger.Errorf("failed to remove sold_out_chair from redis, id: %v", id)
+		}
+	}
+	if err := purgeNginxCache(fmt.Sprintf("/api/chair/%d", id)); err != nil {
+		c.Echo().Logger.Errorf("failed to purge nginx cache, id: %v : %v", id, err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+func putEstate(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.Echo().Logger.Infof("Request parameter \"id\" parse error : %v", err)
+		return newAPIError(http.StatusBadRequest, ErrCodeInvalidID, "id must be an integer")
+	}
+	var req PutEstateRequest
+	if err := bindAndValidate(c, &req); err != nil {
+		c.Echo().Logger.Infof("put estate failed : %v", err)
+		return newValidationError(err)
+	}
+	if err := validateListFields(c,
+		listField{name: "features", value: &req.Features, cond: currentSearchConditions().Estate.Feature, multi: true},
+	); err != nil {
+		return err
+	}
+
+	q := NewEstateSQL().Update().
+		SetName(req.Name).
+		SetDescription(req.Description).
+		SetThumbnail(req.Thumbnail).
+		SetAddress(req.Address).
+		SetLatitude(req.Latitude).
+		SetLongitude(req.Longitude).
+		SetRent(req.Rent).
+		SetDoorHeight(req.DoorHeight).
+		SetDoorWidth(req.DoorWidth).
+		SetFeatures(req.Features).
+		SetPopularity(req.Popularity)
+	// statusは省略されたら今の公開状態のまま
+	if req.Status != "" {
+		q = q.SetStatus(req.Status)
+	}
+	return updateEstate(c, id, *req.Version, q)
+}
+
+func patchEstate(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.Echo().Logger.Infof("Request parameter \"id\" parse error : %v", err)
+		return newAPIError(http.StatusBadRequest, ErrCodeInvalidID, "id must be an integer")
+	}
+	var req PatchEstateRequest
+	if err := bindAndValidate(c, &req); err != nil {
+		c.Echo().Logger.Infof("patch estate failed : %v", err)
+		return newValidationError(err)
+	}
+	if err := validateListFields(c,
+		listField{name: "features", value: req.Features, cond: currentSearchConditions().Estate.Feature, multi: true},
+	); err != nil {
+		return err
+	}
+
+	q := NewEstateSQL().Update()
+	n := 0
+	if req.Name != nil {
+		q, n = q.SetName(*req.Name), n+1
+	}
+	if req.Description != nil {
+		q, n = q.SetDescription(*req.Description), n+1
+	}
+	if req.Thumbnail != nil {
+		q, n = q.SetThumbnail(*req.Thumbnail), n+1
+	}
+	if req.Address != nil {
+		q, n = q.SetAddress(*req.Address), n+1
+	}
+	if req.Latitude != nil {
+		q, n = q.SetLatitude(*req.Latitude), n+1
+	}
+	if req.Longitude != nil {
+		q, n = q.SetLongitude(*req.Longitude), n+1
+	}
+	if req.Rent != nil {
+		q, n = q.SetRent(*req.Rent), n+1
+	}
+	if req.DoorHeight != nil {
+		q, n = q.SetDoorHeight(*req.DoorHeight), n+1
+	}
+	if req.DoorWidth != nil {
+		q, n = q.SetDoorWidth(*req.DoorWidth), n+1
+	}
+	if req.Features != nil {
+		q, n = q.SetFeatures(*req.Features), n+1
+	}
+	if req.Popularity != nil {
+		q, n = q.SetPopularity(*req.Popularity), n+1
+	}
+	if req.Status != nil {
+		q, n = q.SetStatus(*req.Status), n+1
+	}
+	if n == 0 {
+		return newAPIError(http.StatusBadRequest, ErrCodeBadRequest, "no fields to update")
+	}
+	return updateEstate(c, id, *req.Version, q)
+}
+
+// updateEstate versionが変わっていなければqで更新してversionを1増やし、更新後の物件を返す
+func updateEstate(c echo.Context, id, version int64, q estateUpdateSQL) error {
+	ctx := c.Request().Context()
+	tx, err := estateDB.BeginTxx(ctx, nil)
+	if err != nil {
+		return errInternal(fmt.Errorf("failed to begin tx : %w", err))
+	}
+	defer tx.Rollback()
+
+	res, err := q.SetVersion(version+1).WhereID(id).WhereVersion(version).ExecContext(ctx, tx)
+	if err != nil {
+		return errInternal(fmt.Errorf("estate update failed : %w", err))
+	}
+	if n, err := res.RowsAffected(); err != nil {
+		return errInternal(fmt.Errorf("estate update failed : %w", err))
+	} else if n == 0 {
+		return versionConflictError(ctx, tx, "estate", id, version, ErrCodeEstateNotFound)
+	}
+
+	var estate Estate
+	if err := tx.GetContext(ctx, &estate, "SELECT * FROM estate WHERE id = ?", id); err != nil {
+		return errInternal(fmt.Errorf("failed to get the estate from id : %w", err))
+	}
+	if err := tx.Commit(); err != nil {
+		return errInternal(fmt.Errorf("failed to commit tx : %w", err))
+	}
+
+	lowPricedEstates.remove(id)
+	lowPricedEstates.add([]Estate{estate})
+	if err := purgeNginxCache(fmt.Sprintf("/api/estate/%d", id)); err != nil {
+		c.Echo().Logger.Errorf("failed to purge nginx cache, id: %v : %v", id, err)
+	}
+
+	return c.JSON(http.StatusOK, estate)
+}
+
+// deleteEstate 物件をarchivedにして見えなくする。hard=trueなら行ごと消す
+func deleteEstate(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.Echo().Logger.Infof("Request parameter \"id\" parse error : %v", err)
+		return newAPIError(http.StatusBadRequest, ErrCodeInvalidID, "id must be an integer")
+	}
+	version, err := versionParam(c)
+	if err != nil {
+		return err
+	}
+	hard, err := boolQueryParam(c, "hard", false)
+	if err != nil {
+		return err
+	}
+
+	ctx := c.Request().Context()
+	tx, err := estateDB.BeginTxx(ctx, nil)
+	if err != nil {
+		return errInternal(fmt.Errorf("failed to begin tx : %w", err))
+	}
+	defer tx.Rollback()
+
+	var res sql.Result
+	if hard {
+		res, err = NewEstateSQL().Delete().ID(id).Version(version).ExecContext(ctx, tx)
+	} else {
+		res, err = NewEstateSQL().Update().SetStatus(StatusArchived).SetVersion(version+1).WhereID(id).WhereVersion(version).ExecContext(ctx, tx)
+	}
+	if err != nil {
+		return errInternal(fmt.Errorf("estate delete failed : %w", err))
+	}
+	if n, err := res.RowsAffected(); err != nil {
+		return errInternal(fmt.Errorf("estate delete failed : %w", err))
+	} else if n == 0 {
+		return versionConflictError(ctx, tx, "estate", id, version, ErrCodeEstateNotFound)
+	}
+	if err := tx.Commit(); err != nil {
+		return errInternal(fmt.Errorf("failed to commit tx : %w", err))
+	}
+
+	lowPricedEstates.remove(id)
+	if err := purgeNginxCache(fmt.Sprintf("/api/estate/%d", id)); err != nil {
+		c.Echo().Logger.Errorf("failed to purge nginx cache, id: %v : %v", id, err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// versionParam DELETEはボディを持たないのでversionクエリパラメータで受け取る
+func versionParam(c echo.Context) (int64, error) {
+	s := c.QueryParam("version")
+	if s == "" {
+		return 0, newAPIError(http.StatusBadRequest, ErrCodeBadRequest, "version is required")
+	}
+	version, err := strconv.ParseInt(s, 10, 64)
+	if err != nil || version < 0 {
+		c.Logger().Infof("Invalid format version parameter : %v", s)
+		return 0, newAPIError(http.StatusBadRequest, ErrCodeBadRequest, "version must be a non-negative integer")
+	}
+	return version, nil
+}
+
+// versionConflictError 更新や削除で1行も変わらなかったとき、行がないのかversionが古いのかを返す
+func versionConflictError(ctx context.Context, tx *sqlx.Tx, table string, id, version int64, notFound ErrorCode) error {
+	var current int64
+	err := tx.GetContext(ctx, &current, "SELECT version FROM "+table+" WHERE id = ?", id)
+	if err == sql.ErrNoRows {
+		return newAPIError(http.StatusNotFound, notFound, fmt.Sprintf("%s %d not found", table, id))
+	}
+	if err != nil {
+		return errInternal(fmt.Errorf("failed to get %s version : %w", table, err))
+	}
+	return newAPIError(http.StatusConflict, ErrCodeVersionConflict, fmt.Sprintf("%s %d has version %d but %d was given", table, id, current, version))
+}
@@ -0,0 +1,67 @@
+package main
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestValidateListFields(t *testing.T) {
+	kinds := ListCondition{List: []string{"ゲーミングチェア", "座椅子"}}
+	features := ListCondition{List: []string{"肘掛け", "キャスター"}}
+	str := func(s string) *string { return &s }
+	tests := []struct {
+		name       string
+		fields     []listField
+		wantErrors []FieldError
+	}{
+		{
+			name: "known values",
+			fields: []listField{
+				{name: "kind", value: str("座椅子"), cond: kinds},
+				{name: "features", value: str("肘掛け,キャスター"), cond: features, multi: true},
+			},
+		},
+		{
+			name: "not specified",
+			fields: []listField{
+				{name: "kind", cond: kinds},
+				{name: "features", value: str(""), cond: features, multi: true},
+			},
+		},
+		{
+			name: "unknown values",
+			fields: []listField{
+				{name: "kind", value: str("ソファ"), cond: kinds},
+				{name: "features", value: str("肘掛け,リクライニング"), cond: features, multi: true},
+			},
+			wantErrors: []FieldError{
+				{Field: "kind", Message: `has an unknown value : "ソファ"`},
+				{Field: "features", Message: `has an unknown value : "リクライニング"`},
+			},
+		},
+		{
+			name:       "empty single value",
+			fields:     []listField{{name: "kind", value: str(""), cond: kinds}},
+			wantErrors: []FieldError{{Field: "kind", Message: `has an unknown value : ""`}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateListFields(newTestContext(""), tt.fields...)
+			if tt.wantErrors == nil {
+				if err != nil {
+					t.Fatalf("validateListFields() error = %v", err)
+				}
+				return
+			}
+			var ae *APIError
+			if !errors.As(err, &ae) || ae.Code != ErrCodeValidationFailed {
+				t.Fatalf("validateListFields() error = %v, want code %s", err, ErrCodeValidationFailed)
+			}
+			if !reflect.DeepEqual(ae.Errors, tt.wantErrors) {
+				t.Errorf("validateListFields() errors = %+v, want %+v", ae.Errors, tt.wantErrors)
+			}
+		})
+	}
+}
@@ -0,0 +1,188 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"slices"
+
+	"github.com/labstack/echo/v4"
+)
+
+// uploadFormat 入稿の形式。Content-Typeで決める
+type uploadFormat string
+
+const (
+	// uploadFormatCSV multipart/form-dataのchairs/estatesのファイル
+	uploadFormatCSV uploadFormat = "csv"
+	// uploadFormatJSON application/jsonの本文に、1件ずつのオブジェクトを並べた配列
+	uploadFormatJSON uploadFormat = "json"
+	// uploadFormatNDJSON application/x-ndjsonの本文に、1行に1件ずつのオブジェクト
+	uploadFormatNDJSON uploadFormat = "ndjson"
+)
+
+var uploadFormats = map[string]uploadFormat{
+	echo.MIMEMultipartForm:   uploadFormatCSV,
+	echo.MIMEApplicationJSON: uploadFormatJSON,
+	"application/x-ndjson":   uploadFormatNDJSON,
+}
+
+// uploadFormatParam Content-Typeから入稿の形式を決める
+func uploadFormatParam(c echo.Context) (uploadFormat, error) {
+	ct := c.Request().Header.Get(echo.HeaderContentType)
+	mediaType, _, err := mime.ParseMediaType(ct)
+	if err == nil {
+		if f, ok := uploadFormats[mediaType]; ok {
+			return f, nil
+		}
+	}
+	c.Logger().Infof("Unsupported upload content type : %v", ct)
+	return "", newAPIError(http.StatusUnsupportedMediaType, ErrCodeUnsupportedMediaType, fmt.Sprintf("content type must be multipart/form-data, application/json or application/x-ndjson : %q", ct))
+}
+
+// uploadBody 入稿の本文を開く。CSVはfieldのファイル、JSONとNDJSONはリクエストの本文そのもの
+func uploadBody(c echo.Context, field string, format uploadFormat) (io.ReadCloser, error) {
+	if format != uploadFormatCSV {
+		return c.Request().Body, nil
+	}
+	header, err := c.FormFile(field)
+	if err != nil {
+		c.Logger().Errorf("failed to get form file: %v", err)
+		return nil, newAPIError(http.StatusBadRequest, ErrCodeInvalidUpload, "csv file not found in form")
+	}
+	f, err := header.Open()
+	if err != nil {
+		return nil, errInternal(fmt.Errorf("failed to open form file: %w", err))
+	}
+	return f, nil
+}
+
+// chairUpload JSONとNDJSONで入稿する椅子の1件。Chairのjsonタグで読み、レスポンスでは返さないpopularityとstockも受け取る
+type chairUpload struct {
+	Chair
+	Popularity int64 `json:"popularity"`
+	Stock      int64 `json:"stock"`
+}
+
+// chairUploadKeys chairUploadで必須のキー。CSVの列と同じ項目で、これ以外のキーは受け付けない
+var chairUploadKeys = []string{"id", "name", "description", "thumbnail", "price", "height", "width", "depth", "color", "features", "kind", "popularity", "stock"}
+
+func (u chairUpload) row() uploadRow {
+	return uploadRow{
+		ID:     u.ID,
+		Values: []interface{}{u.ID, u.Name, u.Description, u.Thumbnail, u.Price, u.Height, u.Width, u.Depth, u.Color, u.Features, u.Kind, u.Popularity, u.Stock, StatusActive},
+	}
+}
+
+// estateUpload JSONとNDJSONで入稿する物件の1件。Estateのjsonタグで読み、レスポンスでは返さないpopularityも受け取る
+type estateUpload struct {
+	Estate
+	Popularity int64 `json:"popularity"`
+}
+
+// estateUploadKeys estateUploadで必須のキー。CSVの列と同じ項目で、これ以外のキーは受け付けない
+var estateUploadKeys = []string{"id", "name", "description", "thumbnail", "address", "latitude", "longitude", "rent", "doorHeight", "doorWidth", "features", "popularity"}
+
+func (u estateUpload) row() uploadRow {
+	return uploadRow{
+		ID:     u.ID,
+		Values: []interface{}{u.ID, u.Name, u.Description, u.Thumbnail, u.Address, u.Latitude, u.Longitude, u.Rent, u.DoorHeight, u.DoorWidth, u.Features, u.Popularity, StatusActive},
+	}
+}
+
+// jsonRowSource JSONの配列かNDJSONを1件ずつ読んでTにし、rowで値にする。
+// 読めなかった1件はuploadRowErrorを返す。その行番号はNDJSONでは1始まりの行、JSONの配列では1始まりの要素の番号
+func jsonRowSource[T any](f io.Reader, format uploadFormat, keys []string, row func(T) uploadRow) (func() (uploadRow, error), error) {
+	if format == uploadFormatNDJSON {
+		br := bufio.NewReader(f)
+		line := 0
+		return func() (uploadRow, error) {
+			for {
+				b, err := br.ReadBytes('\n')
+				if len(b) == 0 && err != nil {
+					return uploadRow{}, err
+				}
+				line++
+				if len(bytes.TrimSpace(b)) == 0 {
+					continue
+				}
+				v, err := decodeUploadObject[T](b, keys)
+				if err != nil {
+					return uploadRow{}, &uploadRowError{Line: line, Err: err}
+				}
+				r := row(v)
+				r.Line = line
+				return r, nil
+			}
+		}, nil
+	}
+
+	dec := json.NewDecoder(f)
+	if t, err := dec.Token(); err != nil || t != json.Delim('[') {
+		return nil, newAPIError(http.StatusBadRequest, ErrCodeInvalidUpload, "json upload must be an array")
+	}
+	n := 0
+	return func() (uploadRow, error) {
+		if !dec.More() {
+			if _, err := dec.Token(); err != nil {
+				return uploadRow{}, newAPIError(http.StatusBadRequest, ErrCodeInvalidUpload, fmt.Sprintf("invalid json: %v", err))
+			}
+			return uploadRow{}, io.EOF
+		}
+		n++
+		// 配列の途中で構文が壊れていると続きを読めないので、その要素だけ飛ばすことはできない
+		var raw json.RawMessage
+		if err := dec.Decode(&raw); err != nil {
+			return uploadRow{}, newAPIError(http.StatusBadRequest, ErrCodeInvalidUpload, fmt.Sprintf("invalid json at element %d: %v", n, err))
+		}
+		v, err := decodeUploadObject[T](raw, keys)
+		if err != nil {
+			return uploadRow{}, &uploadRowError{Line: n, Err: err}
+		}
+		r := row(v)
+		r.Line = n
+		return r, nil
+	}, nil
+}
+
+// decodeUploadObject 1件のオブジェクトにkeysがすべてnullでなくあり、それ以外のキーがないことを確かめてからTに読む
+func decodeUploadObject[T any](b []byte, keys []string) (T, error) {
+	var v T
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(b, &fields); err != nil {
+		return v, err
+	}
+	for _, k := range keys {
+		raw, ok := fields[k]
+		if !ok {
+			return v, fmt.Errorf("%q is missing", k)
+		}
+		// nullはゼロ値に読めてしまうが、CSVでは書けない値なので受け付けない
+		if bytes.Equal(bytes.TrimSpace(raw), []byte("null")) {
+			return v, fmt.Errorf("%q must not be null", k)
+		}
+	}
+	names := make([]string, 0, len(fields))
+	for k := range fields {
+		names = append(names, k)
+	}
+	slices.Sort(names)
+	for _, k := range names {
+		if !slices.Contains(keys, k) {
+			return v, fmt.Errorf("%q is unknown", k)
+		}
+	}
+	if err := json.Unmarshal(b, &v); err != nil {
+		var te *json.UnmarshalTypeError
+		if errors.As(err, &te) {
+			return v, fmt.Errorf("%q must be %s but got %s", te.Field, te.Type, te.Value)
+		}
+		return v, err
+	}
+	return v, nil
+}
@@ -0,0 +1,32 @@
+package main
+
+import "testing"
+
+func TestDecodeUploadObject(t *testing.T) {
+	const valid = `"id":1,"name":"n","description":"d","thumbnail":"t","address":"a","latitude":35.5,"longitude":139.5,"rent":100,"doorHeight":80,"doorWidth":90,"features":"","popularity":5`
+	tests := []struct {
+		name    string
+		in      string
+		wantErr string
+	}{
+		{name: "valid", in: `{` + valid + `}`},
+		{name: "null", in: `{` + valid + `,"rent":null}`, wantErr: `"rent" must not be null`},
+		{name: "missing", in: `{"id":1}`, wantErr: `"name" is missing`},
+		{name: "unknown", in: `{` + valid + `,"status":"hidden"}`, wantErr: `"status" is unknown`},
+		{name: "wrong type", in: `{` + valid + `,"rent":"100"}`, wantErr: `"rent" must be int64 but got string`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := decodeUploadObject[estateUpload]([]byte(tt.in), estateUploadKeys)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("decodeUploadObject() error = %v", err)
+				}
+				return
+			}
+			if err == nil || err.Error() != tt.wantErr {
+				t.Errorf("decodeUploadObject() error = %v, want %s", err, tt.wantErr)
+			}
+		})
+	}
+}
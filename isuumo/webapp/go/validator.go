@@ -0,0 +1,114 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+)
+
+// requestValidator validateタグでリクエストの構造体を検証する echo.Validator
+type requestValidator struct {
+	validate *validator.Validate
+}
+
+func newRequestValidator() *requestValidator {
+	v := validator.New()
+	// エラーのフィールド名はクライアントが送ってきたjsonのキーに合わせる
+	v.RegisterTagNameFunc(func(f reflect.StructField) string {
+		name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+		if name == "-" {
+			return ""
+		}
+		return name
+	})
+	return &requestValidator{validate: v}
+}
+
+func (v *requestValidator) Validate(i interface{}) error {
+	return v.validate.Struct(i)
+}
+
+type FieldError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// bindAndValidate リクエストボディを構造体に読み込んでvalidateタグで検証する
+func bindAndValidate(c echo.Context, i interface{}) error {
+	if err := c.Bind(i); err != nil {
+		return err
+	}
+	return c.Validate(i)
+}
+
+// newValidationError bindAndValidateのエラーをフィールドごとのメッセージを持つ400のAPIErrorにする
+func newValidationError(err error) *APIError {
+	var ves validator.ValidationErrors
+	if errors.As(err, &ves) {
+		ae := newAPIError(http.StatusBadRequest, ErrCodeValidationFailed, "validation failed")
+		ae.Errors = make([]FieldError, 0, len(ves))
+		for _, fe := range ves {
+			ae.Errors = append(ae.Errors, FieldError{
+				Field:   fieldPath(fe),
+				Message: fieldErrorMessage(fe),
+			})
+		}
+		return ae
+	}
+
+	var he *echo.HTTPError
+	if errors.As(err, &he) {
+		return newAPIError(http.StatusBadRequest, ErrCodeBadRequest, fmt.Sprint(he.Message))
+	}
+	return newAPIError(http.StatusBadRequest, ErrCodeBadRequest, err.Error())
+}
+
+// fieldPath 先頭の構造体名を除いた "coordinates[3].latitude" のようなパスを返す
+func fieldPath(fe validator.FieldError) string {
+	ns := fe.Namespace()
+	if _, path, ok := strings.Cut(ns, "."); ok {
+		return path
+	}
+	return ns
+}
+
+func fieldErrorMessage(fe validator.FieldError) string {
+	isCollection := false
+	switch fe.Kind() {
+	case reflect.Slice, reflect.Array, reflect.Map:
+		isCollection = true
+	}
+
+	switch fe.Tag() {
+	case "required":
+		return "is required"
+	case "email":
+		return "must be a valid email address"
+	case "min":
+		if isCollection {
+			return fmt.Sprintf("must contain at least %s items", fe.Param())
+		}
+		return fmt.Sprintf("must be at least %s", fe.Param())
+	case "max":
+		if isCollection {
+			return fmt.Sprintf("must contain at most %s items", fe.Param())
+		}
+		return fmt.Sprintf("must be at most %s", fe.Param())
+	case "gt":
+		return fmt.Sprintf("must be greater than %s", fe.Param())
+	case "gte":
+		return fmt.Sprintf("must be greater than or equal to %s", fe.Param())
+	case "lt":
+		return fmt.Sprintf("must be less than %s", fe.Param())
+	case "lte":
+		return fmt.Sprintf("must be less than or equal to %s", fe.Param())
+	case "oneof":
+		return fmt.Sprintf("must be one of: %s", strings.Join(strings.Fields(fe.Param()), ", "))
+	}
+	return fmt.Sprintf("failed on the '%s' rule", fe.Tag())
+}
@@ -0,0 +1,32 @@
+package main
+
+// 椅子と物件の公開状態。hiddenは一時的に非公開、archivedは削除済みとして扱う
+const (
+	StatusActive   = "active"
+	StatusHidden   = "hidden"
+	StatusArchived = "archived"
+)
+
+// 一覧や詳細で利用者に見せる行の条件。読み込みのハンドラはすべてこれで絞り、個別に stock > 0 などを書かない。
+// 椅子の売り切れは公開状態の特別な場合として扱う
+const (
+	visibleChairCondition  = "status = 'active' AND stock > 0"
+	visibleEstateCondition = "status = 'active'"
+)
+
+// visible visibleChairConditionと同じ判定をGo側で行う。low_pricedのキャッシュに入れるかどうかに使う
+func (c Chair) visible() bool {
+	return c.Status == StatusActive && c.Stock > 0
+}
+
+// soldOut 在庫が0なだけで、在庫があればvisibleになる椅子か。売り切れと非公開を分けて返すのに使う
+func (c Chair) soldOut() bool {
+	restocked := c
+	restocked.Stock = 1
+	return !c.visible() && restocked.visible()
+}
+
+// visible visibleEstateConditionと同じ判定をGo側で行う
+func (e Estate) visible() bool {
+	return e.Status == StatusActive
+}
@@ -6,6 +6,13 @@
 
 truncate table estate;
 truncate table chair;
+truncate table orders;
+truncate table document_requests;
+truncate table chair_stock_audits;
+truncate table import_jobs;
+
+-- q による全文検索用。日本語も分かち書きせずにトライグラムの部分一致で探す
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
 
 CREATE TABLE isuumo.estate
 (
@@ -40,6 +47,72 @@ CREATE TABLE isuumo.chair
     stock       INTEGER         NOT NULL
 );
 
+CREATE TABLE IF NOT EXISTS isuumo.orders
+(
+    id          BIGSERIAL       NOT NULL PRIMARY KEY,
+    chair_id    INTEGER         NOT NULL,
+    email       VARCHAR(128)    NOT NULL,
+    price       INTEGER         NOT NULL,
+    quantity    INTEGER         NOT NULL DEFAULT 1,
+    created_at  TIMESTAMP       NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+create index orders_email_id_index
+    on isuumo.orders (email, id desc);
+
+CREATE TABLE IF NOT EXISTS isuumo.chair_stock_audits
+(
+    id           BIGSERIAL       NOT NULL PRIMARY KEY,
+    chair_id     INTEGER         NOT NULL,
+    operation    VARCHAR(16)     NOT NULL,
+    amount       INTEGER         NOT NULL,
+    stock_before INTEGER         NOT NULL,
+    stock_after  INTEGER         NOT NULL,
+    reason       VARCHAR(255)    NOT NULL DEFAULT '',
+    created_at   TIMESTAMP       NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+create index chair_stock_audits_chair_id_id_index
+    on isuumo.chair_stock_audits (chair_id, id desc);
+
+-- 非同期の入稿のジョブ。アップロードされたファイルは受け付けたサーバーの path に置く
+CREATE TABLE IF NOT EXISTS isuumo.import_jobs
+(
+    id             BIGSERIAL       NOT NULL PRIMARY KEY,
+    target         VARCHAR(16)     NOT NULL,
+    format         VARCHAR(16)     NOT NULL DEFAULT 'csv',
+    mode           VARCHAR(16)     NOT NULL,
+    header         BOOLEAN         NOT NULL DEFAULT FALSE,
+    host           VARCHAR(255)    NOT NULL,
+    path           VARCHAR(1024)   NOT NULL,
+    status         VARCHAR(16)     NOT NULL DEFAULT 'pending',
+    rows_done      BIGINT          NOT NULL DEFAULT 0,
+    inserted       BIGINT          NOT NULL DEFAULT 0,
+    updated        BIGINT          NOT NULL DEFAULT 0,
+    rejected       BIGINT          NOT NULL DEFAULT 0,
+    rejected_lines TEXT            NOT NULL DEFAULT '[]',
+    error          TEXT            NOT NULL DEFAULT '',
+    created_at     TIMESTAMP       NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at     TIMESTAMP       NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+create index import_jobs_host_status_id_index
+    on isuumo.import_jobs (host, status, id);
+
+CREATE TABLE IF NOT EXISTS isuumo.document_requests
+(
+    id          BIGSERIAL       NOT NULL PRIMARY KEY,
+    estate_id   INTEGER         NOT NULL,
+    email       VARCHAR(128)    NOT NULL,
+    created_at  TIMESTAMP       NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+create index document_requests_estate_id_id_index
+    on isuumo.document_requests (estate_id, id desc);
+
+create index document_requests_estate_id_email_created_at_index
+    on isuumo.document_requests (estate_id, email, created_at);
+
 create index estate_latitude_longitude_popularity_id_index
     on isuumo.estate (latitude asc, longitude asc, popularity desc, id asc);
 
@@ -58,14 +131,45 @@ create index chair_popularity_id_index
 create index chair_stock_price_id_index
     on isuumo.chair (stock, price, id);
 
+-- sort=price/-price/rent/-rent 用。sort=newest は主キーを使う
+create index chair_price_id_index
+    on isuumo.chair (price asc, id asc);
+
+create index chair_price_desc_id_index
+    on isuumo.chair (price desc, id asc);
+
+create index estate_rent_id_index
+    on isuumo.estate (rent asc, id asc);
+
+create index estate_rent_desc_id_index
+    on isuumo.estate (rent desc, id asc);
+
+-- 式は Go の textSearchColumn と揃える
+create index chair_name_description_trgm_index
+    on isuumo.chair using gin ((name || ' ' || description) gin_trgm_ops);
+
+create index estate_name_description_trgm_index
+    on isuumo.estate using gin ((name || ' ' || description) gin_trgm_ops);
+
 create index estate_door_recommend_index
     on isuumo.estate (door_height, door_width, popularity desc, id asc);
 
+-- PUT/PATCH/DELETE の楽観ロック用。更新のたびに1増やす
+ALTER TABLE isuumo.estate ADD COLUMN IF NOT EXISTS version INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE isuumo.chair ADD COLUMN IF NOT EXISTS version INTEGER NOT NULL DEFAULT 0;
+
+-- 公開状態。読み込みは Go の visibleEstateCondition / visibleChairCondition で絞る
+ALTER TABLE isuumo.estate ADD COLUMN IF NOT EXISTS status VARCHAR(16) NOT NULL DEFAULT 'active'
+    CHECK (status IN ('active', 'hidden', 'archived'));
+ALTER TABLE isuumo.chair ADD COLUMN IF NOT EXISTS status VARCHAR(16) NOT NULL DEFAULT 'active'
+    CHECK (status IN ('active', 'hidden', 'archived'));
+
 -- chair
 ALTER TABLE isuumo.chair ADD COLUMN features_array text[] GENERATED ALWAYS AS (regexp_split_to_array(features, ',')) STORED;
 
 CREATE INDEX idx_features_array ON chair USING gin(features_array);
 
+-- *_range 列の境界は fixture/*_condition.json の ranges と一致させる。起動時に検証し、ずれていれば `isuumo rebucket` で作り直す
 ALTER TABLE isuumo.chair
 ADD COLUMN price_range int GENERATED ALWAYS AS (CASE WHEN price < 3000 THEN 0 WHEN 3000 <= price and price < 6000 THEN 1 WHEN 6000 <= price and price < 9000 THEN 2 WHEN 9000 <= price and price < 12000 THEN 3 WHEN 12000 <= price and price < 15000 THEN 4 WHEN 15000 <= price THEN 5 END) STORED;
 